@@ -0,0 +1,41 @@
+package alter
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/broker"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newAlterBrokerLoggerCmd() *cobra.Command {
+
+	var flags broker.AlterBrokerLoggerFlags
+
+	var cmdAlterBrokerLogger = &cobra.Command{
+		Use:   "broker-logger BROKER LOGGER=LEVEL...",
+		Short: "alter the log level of loggers of a broker",
+		Long: `alter the log level of loggers of a broker.
+Levels are one of TRACE|DEBUG|INFO|WARN|ERROR|FATAL|OFF. An empty level (LOGGER=) resets the logger to the level of the root logger.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				if flags.RevertAfter > 0 {
+					return errors.New("parameter --revert-after is not supported when running in kubernetes")
+				}
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&broker.Operation{}).AlterBrokerLogger(args[0], args[1:], flags)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return broker.CompleteBrokerIDs(cmd, args, toComplete)
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmdAlterBrokerLogger.Flags().DurationVarP(&flags.RevertAfter, "revert-after", "", 0, "revert to the previous log levels after the given duration (e.g. 15m)")
+
+	return cmdAlterBrokerLogger
+}
@@ -0,0 +1,41 @@
+package alter_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestAlterBrokerLoggerIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	logger := "kafka.controller"
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("alter", "broker-logger", "101", logger+"=trace", "--revert-after", "1s"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, "logger kafka.controller of broker 101 set to TRACE", kafkaCtl.GetStdOut())
+	testutil.AssertContainSubstring(t, "logger kafka.controller of broker 101 reverted to", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "broker-loggers", "101", "--filter", logger); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainNoSubstring(t, "TRACE", kafkaCtl.GetStdOut())
+}
+
+func TestAlterBrokerLoggerInvalidLevelIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("alter", "broker-logger", "101", "kafka.controller=verbose")
+	testutil.AssertErrorContains(t, "invalid log level \"VERBOSE\" for logger kafka.controller", err)
+}
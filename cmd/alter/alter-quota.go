@@ -0,0 +1,47 @@
+package alter
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/cmd/validation"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/quota"
+	"github.com/spf13/cobra"
+)
+
+func newAlterQuotaCmd() *cobra.Command {
+
+	var flags quota.AlterQuotaFlags
+
+	var cmdAlterQuota = &cobra.Command{
+		Use:   "quota",
+		Short: "set the client quota of a user and/or client-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&quota.Operation{}).AlterQuota(flags)
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return validation.ValidateAtLeastOneRequiredFlag(cmd)
+		},
+	}
+
+	cmdAlterQuota.Flags().StringVarP(&flags.User, "user", "u", "", "user of the quota")
+	cmdAlterQuota.Flags().StringVarP(&flags.ClientID, "client-id", "c", "", "client-id of the quota")
+	cmdAlterQuota.Flags().BoolVarP(&flags.DefaultUser, "default-user", "", false, "quota of the default user")
+	cmdAlterQuota.Flags().BoolVarP(&flags.DefaultClientID, "default-client-id", "", false, "quota of the default client-id")
+	cmdAlterQuota.Flags().StringVarP(&flags.ProducerByteRate, "producer-byte-rate", "", "", "max bytes per second produced per broker (e.g. 1048576 or 10MB)")
+	cmdAlterQuota.Flags().StringVarP(&flags.ConsumerByteRate, "consumer-byte-rate", "", "", "max bytes per second consumed per broker (e.g. 1048576 or 10MB)")
+	cmdAlterQuota.Flags().StringVarP(&flags.RequestPercentage, "request-percentage", "", "", "max percentage of request handler and network threads time")
+	cmdAlterQuota.Flags().StringVarP(&flags.ControllerMutationRate, "controller-mutation-rate", "", "", "max rate of partition mutations (create/delete topics or partitions) per second")
+	cmdAlterQuota.Flags().BoolVarP(&flags.ValidateOnly, "validate-only", "v", false, "validate only")
+
+	for _, flag := range []string{"producer-byte-rate", "consumer-byte-rate", "request-percentage", "controller-mutation-rate"} {
+		if err := validation.MarkFlagAtLeastOneRequired(cmdAlterQuota.Flags(), flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmdAlterQuota
+}
@@ -0,0 +1,58 @@
+package alter_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/quota"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+	"gopkg.in/yaml.v2"
+)
+
+func TestAlterQuotaIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	clientID := testutil.GetPrefixedName("alter-quota-")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("alter", "quota", "--client-id", clientID, "--producer-byte-rate", "1MB", "--request-percentage", "50"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "quota of client-id '"+clientID+"' has been altered", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("describe", "quota", "--client-id", clientID, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	var described quota.Quota
+	if err := yaml.Unmarshal([]byte(kafkaCtl.GetStdOut()), &described); err != nil {
+		t.Fatalf("failed to read yaml: %v", err)
+	}
+
+	testutil.AssertEquals(t, clientID, described.ClientID)
+	testutil.AssertIntEquals(t, 1000000, int(described.Values[quota.ProducerByteRate]))
+	testutil.AssertIntEquals(t, 50, int(described.Values[quota.RequestPercentage]))
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "quotas"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, clientID, kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "quota", "--client-id", clientID); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("describe", "quota", "--client-id", clientID)
+	testutil.AssertErrorContains(t, "no quota found for client-id '"+clientID+"'", err)
+}
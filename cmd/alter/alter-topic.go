@@ -13,14 +13,17 @@ func newAlterTopicCmd() *cobra.Command {
 	var flags topic.AlterTopicFlags
 
 	var cmdAlterTopic = &cobra.Command{
-		Use:   "topic TOPIC",
+		Use:   "topic [TOPIC...]",
 		Short: "alter a topic",
-		Args:  cobra.ExactArgs(1),
+		Long: `alter a topic.
+Multiple topics can be altered by names, glob patterns (e.g. 'orders-*') or a regular expression (--pattern).
+Topics selected by patterns are listed and have to be confirmed.`,
+		Args: topic.TopicsOrPattern(&flags.SelectTopicsFlags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
 				return k8s.NewOperation().Run(cmd, args)
 			}
-			return (&topic.Operation{}).AlterTopic(args[0], flags)
+			return (&topic.Operation{}).AlterTopics(args, flags)
 		},
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			return validation.ValidateAtLeastOneRequiredFlag(cmd)
@@ -32,6 +35,8 @@ func newAlterTopicCmd() *cobra.Command {
 	cmdAlterTopic.Flags().Int16VarP(&flags.ReplicationFactor, "replication-factor", "r", flags.ReplicationFactor, "replication factor")
 	cmdAlterTopic.Flags().StringArrayVarP(&flags.Configs, "config", "c", flags.Configs, "configs in format `key=value`")
 	cmdAlterTopic.Flags().BoolVarP(&flags.ValidateOnly, "validate-only", "v", false, "validate only")
+	cmdAlterTopic.Flags().StringVarP(&flags.Pattern, "pattern", "", "", "regular expression the topic names have to match")
+	cmdAlterTopic.Flags().BoolVarP(&flags.ExcludeInternal, "exclude-internal", "", false, "exclude internal topics (starting with __)")
 
 	if err := validation.MarkFlagAtLeastOneRequired(cmdAlterTopic.Flags(), "partitions"); err != nil {
 		panic(err)
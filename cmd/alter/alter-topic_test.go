@@ -316,3 +316,67 @@ func TestAlterTopicConfigK8sIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestAlterTopicsByPatternIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("alter-topics-pattern")
+
+	topicName1 := testutil.CreateTopic(t, prefix+"-a")
+	topicName2 := testutil.CreateTopic(t, prefix+"-b")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("alter", "topic", "--pattern", prefix+"-.*", "--config", "retention.ms=3600000", "--yes"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	for _, topicName := range []string{topicName1, topicName2} {
+		checkConfig := func(_ uint) error {
+			_, err := kafkaCtl.Execute("describe", "topic", topicName, "-o", "yaml")
+			if err != nil {
+				return err
+			}
+			describedTopic, err := topic.FromYaml(kafkaCtl.GetStdOut())
+			if err != nil {
+				return err
+			}
+			for _, c := range describedTopic.Configs {
+				if c.Name == "retention.ms" && c.Value == "3600000" {
+					return nil
+				}
+			}
+			return errors.Newf("retention.ms not altered for topic %s", topicName)
+		}
+
+		err := retry.Retry(
+			checkConfig,
+			strategy.Limit(5),
+			strategy.Backoff(backoff.Linear(10*time.Millisecond)),
+		)
+
+		if err != nil {
+			t.Fatalf("config verification failed for topic %s: %v", topicName, err)
+		}
+	}
+}
+
+func TestAlterTopicsByGlobRequiresConfirmationIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("alter-topics-glob")
+
+	testutil.CreateTopic(t, prefix+"-a")
+	testutil.CreateTopic(t, prefix+"-b")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("alter", "topic", prefix+"-*", "--config", "retention.ms=3600000")
+	if err == nil {
+		t.Fatal("expect alter topic with glob pattern to require confirmation")
+	}
+
+	testutil.AssertErrorContains(t, "confirmation required", err)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/deviceinsight/kafkactl/v5/internal"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
 	"github.com/deviceinsight/kafkactl/v5/internal/user"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +19,9 @@ func newAlterUserCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
+				if flags.Rotate {
+					return errors.New("parameter --rotate is not supported when running in kubernetes")
+				}
 				return k8s.NewOperation().Run(cmd, args)
 			}
 			return (&user.Operation{}).AlterUser(args[0], flags)
@@ -31,10 +35,15 @@ func newAlterUserCmd() *cobra.Command {
 	cmdAlterUser.Flags().StringVarP(&flags.Password, "password", "p", "", "new user password")
 	cmdAlterUser.Flags().StringVarP(&flags.Salt, "salt", "s", "", "custom salt (base64 encoded, generated if not provided)")
 	cmdAlterUser.Flags().Int32VarP(&flags.Iterations, "iterations", "i", 4096, "SCRAM iterations")
+	cmdAlterUser.Flags().BoolVarP(&flags.Rotate, "rotate", "", false, "set a new password for both SCRAM mechanisms, verify the login and update the password of the current context if it uses this user")
+	cmdAlterUser.Flags().BoolVarP(&flags.Generate, "generate", "", false, "generate a strong password when rotating")
 
 	if err := validation.MarkFlagAtLeastOneRequired(cmdAlterUser.Flags(), "password"); err != nil {
 		panic(err)
 	}
+	if err := validation.MarkFlagAtLeastOneRequired(cmdAlterUser.Flags(), "rotate"); err != nil {
+		panic(err)
+	}
 
 	return cmdAlterUser
 }
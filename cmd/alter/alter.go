@@ -15,6 +15,8 @@ func NewAlterCmd() *cobra.Command {
 	cmdAlter.AddCommand(newAlterTopicCmd())
 	cmdAlter.AddCommand(newAlterPartitionCmd())
 	cmdAlter.AddCommand(newAlterBrokerCmd())
+	cmdAlter.AddCommand(newAlterBrokerLoggerCmd())
+	cmdAlter.AddCommand(newAlterQuotaCmd())
 	cmdAlter.AddCommand(newAlterUserCmd())
 	return cmdAlter
 }
@@ -0,0 +1,63 @@
+package check
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/acl"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/spf13/cobra"
+)
+
+func newCheckAccessCmd() *cobra.Command {
+
+	var flags acl.CheckAccessFlags
+
+	var cmdCheckAccess = &cobra.Command{
+		Use:   "access",
+		Short: "check if a principal is allowed to perform an operation on a resource",
+		Long: `check if a principal is allowed to perform an operation on a resource.
+The acls of the resource are evaluated like the kafka authorizer does: literal, prefixed and wildcard
+patterns are matched and deny acls take precedence over allow acls. Super users are not taken into account.`,
+		Example: "kafkactl check access --principal User:app --operation read --topic orders",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&acl.Operation{}).CheckAccess(flags)
+		},
+	}
+
+	cmdCheckAccess.Flags().StringVarP(&flags.Principal, "principal", "p", "", "principal to check, e.g. User:app")
+	cmdCheckAccess.Flags().StringVarP(&flags.Operation, "operation", "", "", "operation to check")
+	cmdCheckAccess.Flags().StringVarP(&flags.Host, "host", "", "", "host the principal connects from. without a host, acls for specific hosts are reported as host dependent")
+
+	cmdCheckAccess.Flags().StringVarP(&flags.Topic, "topic", "", "", "topic to check")
+	cmdCheckAccess.Flags().StringVarP(&flags.Group, "group", "", "", "consumer group to check")
+	cmdCheckAccess.Flags().BoolVarP(&flags.Cluster, "cluster", "", false, "check access to the cluster")
+	cmdCheckAccess.Flags().StringVarP(&flags.TransactionalID, "transactional-id", "", "", "transactional id to check")
+
+	cmdCheckAccess.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	if err := cmdCheckAccess.MarkFlagRequired("principal"); err != nil {
+		panic(err)
+	}
+	if err := cmdCheckAccess.MarkFlagRequired("operation"); err != nil {
+		panic(err)
+	}
+
+	_ = cmdCheckAccess.RegisterFlagCompletionFunc("operation", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"read", "write", "create", "delete", "alter", "describe", "clusteraction", "describeconfigs", "alterconfigs", "idempotentwrite"}, cobra.ShellCompDirectiveDefault
+	})
+
+	_ = cmdCheckAccess.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return topic.CompleteTopicNames(cmd, args, toComplete)
+	})
+
+	_ = cmdCheckAccess.RegisterFlagCompletionFunc("group", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return consumergroups.CompleteConsumerGroups(cmd, args, toComplete)
+	})
+
+	return cmdCheckAccess
+}
@@ -0,0 +1,48 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestCheckAccessIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	topicName := testutil.CreateTopic(t, "check-access-topic")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "--topic", topicName, "--operation", "read", "--allow", "--principal", "User:user"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("check", "access", "--principal", "User:user", "--operation", "describe", "--topic", topicName); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	stdout := kafkaCtl.GetStdOutLines()
+	testutil.AssertEquals(t, "ALLOWED: allowed by acl", stdout[0])
+	testutil.AssertEquals(t, "Topic|"+topicName+"|Literal|User:user|*|Read|Allow", stdout[3])
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("check", "access", "--principal", "User:user", "--operation", "write", "--topic", topicName); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "DENIED: no acl allows the operation", kafkaCtl.GetStdOut())
+}
+
+func TestCheckAccessRequiresResourceIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("check", "access", "--principal", "User:user", "--operation", "read")
+	testutil.AssertErrorContains(t, "either --topic or --group or --cluster or --transactional-id has to be provided", err)
+}
@@ -0,0 +1,38 @@
+package check
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/cluster"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newCheckClusterCmd() *cobra.Command {
+
+	var flags cluster.CheckClusterFlags
+
+	var cmdCheckCluster = &cobra.Command{
+		Use:   "cluster",
+		Short: "report under-replicated and offline partitions, leader imbalance and missing brokers",
+		Long: `report under-replicated and offline partitions, leader imbalance and missing brokers.
+The command fails if a check exceeds its threshold. A negative threshold disables the check.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&cluster.Operation{}).CheckCluster(flags)
+		},
+	}
+
+	cmdCheckCluster.Flags().Int32SliceVarP(&flags.ExpectedBrokers, "expected-brokers", "", nil, "broker ids that have to be available")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.Offline, "max-offline", "", 0, "max number of offline partitions")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.UnderReplicated, "max-under-replicated", "", 0, "max number of under-replicated partitions")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.UnderMinISR, "max-under-min-isr", "", 0, "max number of partitions under min.insync.replicas")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.LeaderImbalance, "max-leader-imbalance", "", 10, "max percentage of partitions a broker is the preferred leader of but does not lead")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.MissingBrokers, "max-missing-brokers", "", 0, "max number of brokers missing from metadata")
+	cmdCheckCluster.Flags().IntVarP(&flags.Thresholds.ReplicationFactorOne, "max-replication-factor-one", "", -1, "max number of topics with replication factor 1")
+	cmdCheckCluster.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	return cmdCheckCluster
+}
@@ -0,0 +1,46 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestCheckClusterIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("check", "cluster", "--max-leader-imbalance", "-1"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	stdout := kafkaCtl.GetStdOut()
+	testutil.AssertContainSubstring(t, "brokers: 101,102,103", stdout)
+	testutil.AssertContainSubstring(t, "offline partitions", stdout)
+	testutil.AssertContainSubstring(t, "under-replicated partitions", stdout)
+}
+
+func TestCheckClusterFailsOnThresholdIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	testutil.CreateTopic(t, "check-cluster-rf1-", "--replication-factor", "1")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("check", "cluster", "--max-leader-imbalance", "-1", "--max-replication-factor-one", "0")
+	testutil.AssertErrorContains(t, "cluster check failed: topics with replication factor 1", err)
+}
+
+func TestCheckClusterMissingBrokerIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("check", "cluster", "--max-leader-imbalance", "-1", "--expected-brokers", "101,104")
+	testutil.AssertErrorContains(t, "cluster check failed: missing brokers", err)
+	testutil.AssertContainSubstring(t, "missing brokers: 104", kafkaCtl.GetStdOut())
+}
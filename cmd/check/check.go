@@ -0,0 +1,16 @@
+package check
+
+import "github.com/spf13/cobra"
+
+func NewCheckCmd() *cobra.Command {
+
+	var cmdCheck = &cobra.Command{
+		Use:   "check",
+		Short: "check the health of the cluster and access of principals",
+	}
+
+	cmdCheck.AddCommand(newCheckClusterCmd())
+	cmdCheck.AddCommand(newCheckAccessCmd())
+
+	return cmdCheck
+}
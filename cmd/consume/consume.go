@@ -9,6 +9,7 @@ import (
 	"github.com/deviceinsight/kafkactl/v5/internal/helpers/protobuf"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
 	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +22,9 @@ func NewConsumeCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
+				if flags.Sink != "" {
+					return errors.New("parameter --sink is not supported when running in kubernetes")
+				}
 				return k8s.NewOperation().Run(cmd, args)
 			}
 			return (&consume.Operation{}).Consume(args[0], flags)
@@ -56,6 +60,11 @@ func NewConsumeCmd() *cobra.Command {
 	cmdConsume.Flags().StringVarP(&flags.FilterKey, "filter-key", "", "", "filter messages keys with glob pattern")
 	cmdConsume.Flags().StringVarP(&flags.FilterValue, "filter-value", "", "", "filter messages values with glob pattern")
 	cmdConsume.Flags().StringToStringVarP(&flags.FilterHeader, "filter-header", "", map[string]string{}, "filter messages headers with glob pattern")
+	cmdConsume.Flags().StringVarP(&flags.Sink, "sink", "", "", "write messages to a sink instead of stdout. Format: file:///path/to/dir")
+	cmdConsume.Flags().StringVarP(&flags.SinkFormat, "format", "", "", "file format of the sink. One of: jsonl|avro-ocf|parquet (default is jsonl)")
+	cmdConsume.Flags().StringVarP(&flags.SinkNaming, "sink-naming", "", "", "naming of sink files. One of: partition|time (default is partition)")
+	cmdConsume.Flags().StringVarP(&flags.RotateSize, "rotate-size", "", "", "rotate sink files when they exceed the given size (e.g. 100MB)")
+	cmdConsume.Flags().DurationVarP(&flags.RotateInterval, "rotate-interval", "", 0, "rotate sink files after the given interval (e.g. 1h)")
 	cmdConsume.Flags().StringVarP(&flags.IsolationLevel, "isolation-level", "i", "", "isolationLevel to use. One of: ReadUncommitted|ReadCommitted")
 
 	if err := cmdConsume.RegisterFlagCompletionFunc("group", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -64,5 +73,17 @@ func NewConsumeCmd() *cobra.Command {
 		panic(err)
 	}
 
+	if err := cmdConsume.RegisterFlagCompletionFunc("format", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{consume.SinkFormatJSONL, consume.SinkFormatAvroOCF, consume.SinkFormatParquet}, cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		panic(err)
+	}
+
+	if err := cmdConsume.RegisterFlagCompletionFunc("sink-naming", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{consume.SinkNamingPartition, consume.SinkNamingTime}, cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		panic(err)
+	}
+
 	return cmdConsume
 }
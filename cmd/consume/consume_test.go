@@ -3,6 +3,7 @@ package consume_test
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -37,6 +38,34 @@ func TestConsumeWithKeyAndValueIntegration(t *testing.T) {
 	testutil.AssertEquals(t, "test-key#test-value", kafkaCtl.GetStdOut())
 }
 
+func TestConsumeToFileSinkIntegration(t *testing.T) {
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "consume-topic", "--partitions", "2")
+
+	testutil.ProduceMessage(t, topicName, "test-key", "test-value", 1, 0)
+
+	sinkDir := t.TempDir()
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("consume", topicName, "--from-beginning", "--exit",
+		"--sink", "file://"+sinkDir, "--format", "jsonl"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, fmt.Sprintf("1 messages written to 1 files in %s", sinkDir), kafkaCtl.GetStdOut())
+
+	content, err := os.ReadFile(filepath.Join(sinkDir, topicName+"-1-0.jsonl"))
+	if err != nil {
+		t.Fatalf("failed to read sink file: %v", err)
+	}
+
+	if !strings.Contains(string(content), `"Key":"test-key"`) || !strings.Contains(string(content), `"Value":"test-value"`) {
+		t.Fatalf("unexpected sink file content: %s", content)
+	}
+}
+
 func TestConsumeWithPartitionAndValueIntegration(t *testing.T) {
 	testutil.StartIntegrationTest(t)
 
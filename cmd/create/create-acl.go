@@ -1,11 +1,13 @@
 package create
 
 import (
+	"github.com/deviceinsight/kafkactl/v5/cmd/validation"
 	"github.com/deviceinsight/kafkactl/v5/internal"
 	"github.com/deviceinsight/kafkactl/v5/internal/acl"
 	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
 	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -20,10 +22,16 @@ func newCreateACLCmd() *cobra.Command {
 		Args:    cobra.MaximumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
+				if flags.File != "" {
+					return errors.New("parameter --file is not supported when running in kubernetes")
+				}
 				return k8s.NewOperation().Run(cmd, args)
 			}
 			return (&acl.Operation{}).CreateACL(flags)
 		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return validation.ValidateAtLeastOneRequiredFlag(cmd)
+		},
 		ValidArgsFunction: acl.CompleteCreateACL,
 	}
 
@@ -40,11 +48,24 @@ func newCreateACLCmd() *cobra.Command {
 	cmdCreateACL.Flags().StringVarP(&flags.Topic, "topic", "t", "", "create acl for a topic")
 	cmdCreateACL.Flags().StringVarP(&flags.Group, "group", "g", "", "create acl for a consumer group")
 	cmdCreateACL.Flags().BoolVarP(&flags.Cluster, "cluster", "c", false, "create acl for the cluster")
+	cmdCreateACL.Flags().StringVarP(&flags.TransactionalID, "transactional-id", "", "", "create acl for a transactional id")
+
+	// specify role
+	cmdCreateACL.Flags().StringVarP(&flags.Role, "role", "", "", "create the acls of a role instead of single operations. one of (producer, consumer, admin)")
+	cmdCreateACL.Flags().BoolVarP(&flags.Idempotent, "idempotent", "", false, "allow idempotent writes to the cluster (role producer)")
 
 	cmdCreateACL.Flags().BoolVarP(&flags.ValidateOnly, "validate-only", "v", false, "validate only")
+	cmdCreateACL.Flags().StringVarP(&flags.File, "file", "f", "", "create all acls of a yaml or json file written by get acl. existing acls are skipped")
 
-	_ = cmdCreateACL.MarkFlagRequired("principal")
-	_ = cmdCreateACL.MarkFlagRequired("operation")
+	if err := validation.MarkFlagAtLeastOneRequired(cmdCreateACL.Flags(), "operation"); err != nil {
+		panic(err)
+	}
+	if err := validation.MarkFlagAtLeastOneRequired(cmdCreateACL.Flags(), "role"); err != nil {
+		panic(err)
+	}
+	if err := validation.MarkFlagAtLeastOneRequired(cmdCreateACL.Flags(), "file"); err != nil {
+		panic(err)
+	}
 
 	_ = cmdCreateACL.RegisterFlagCompletionFunc("pattern", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"match", "prefixed", "literal"}, cobra.ShellCompDirectiveDefault
@@ -54,6 +75,10 @@ func newCreateACLCmd() *cobra.Command {
 		return []string{"any", "all", "read", "write", "create", "delete", "alter", "describe", "clusteraction", "describeconfigs", "alterconfigs", "idempotentwrite"}, cobra.ShellCompDirectiveDefault
 	})
 
+	_ = cmdCreateACL.RegisterFlagCompletionFunc("role", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return acl.Roles, cobra.ShellCompDirectiveDefault
+	})
+
 	_ = cmdCreateACL.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return topic.CompleteTopicNames(cmd, args, toComplete)
 	})
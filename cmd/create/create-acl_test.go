@@ -2,8 +2,11 @@ package create_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/deviceinsight/kafkactl/v5/internal/acl"
 	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
 )
 
@@ -122,3 +125,172 @@ func TestCreateClusterIdempotentWriteAllowAclIntegration(t *testing.T) {
 		t.Fatalf("failed to execute command: %v", err)
 	}
 }
+
+func TestCreateAndDeleteProducerRoleAclIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	topicName := testutil.CreateTopic(t, "acl-role-topic")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "--role", "producer", "--topic", topicName, "--principal", "User:user"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	// switch to to sasl 'user'
+	testutil.SwitchContext("sasl-user")
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	// user should be able to produce
+	if _, err := kafkaCtl.Execute("produce", topicName, "--key", "test-key", "--value", "test-value"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "message produced (partition=0\toffset=0)", kafkaCtl.GetStdOut())
+
+	// switch to to sasl 'admin'
+	testutil.SwitchContext("sasl-admin")
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "acl", "--role", "producer", "--topic", topicName, "--principal", "User:user"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	stdout := kafkaCtl.GetStdOut()
+	testutil.AssertContainSubstring(t, "Write", stdout)
+	testutil.AssertContainSubstring(t, "Describe", stdout)
+	testutil.AssertContainSubstring(t, "Create", stdout)
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "acl", "--resource-name", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	acls, err := acl.FromYaml(kafkaCtl.GetStdOut())
+	if err != nil {
+		t.Fatalf("failed to read yaml: %v", err)
+	}
+	testutil.AssertIntEquals(t, 0, len(acls))
+}
+
+func TestCreateAclRequiresOperationOrRoleIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("create", "acl", "--topic", "some-topic", "--allow", "--principal", "User:user")
+	testutil.AssertErrorContains(t, "At least one of the following flags must be set: file, operation, role", err)
+}
+
+func TestCreateAndDeleteAclsFromFileIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	topicName := testutil.CreateTopic(t, "acl-file-topic")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "--topic", topicName, "--operation", "read", "--operation", "describe", "--allow", "--principal", "User:user"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "acl", "--resource-name", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	aclFile := filepath.Join(t.TempDir(), "acls.yaml")
+	if err := os.WriteFile(aclFile, []byte(kafkaCtl.GetStdOut()), 0o600); err != nil {
+		t.Fatalf("failed to write acl file: %v", err)
+	}
+
+	// existing acls are skipped
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "-f", aclFile); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "all acls already exist", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "acl", "-f", aclFile); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertIntEquals(t, 3, len(kafkaCtl.GetStdOutLines()))
+
+	// acls are restored from the file
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "-f", aclFile); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "acl", "--resource-name", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	acls, err := acl.FromYaml(kafkaCtl.GetStdOut())
+	if err != nil {
+		t.Fatalf("failed to read yaml: %v", err)
+	}
+	testutil.AssertIntEquals(t, 1, len(acls))
+	testutil.AssertIntEquals(t, 2, len(acls[0].Acls))
+}
+
+func TestDeleteAclsFromFileValidateOnlyIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	topicName := testutil.CreateTopic(t, "acl-file-validate-topic")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl", "--topic", topicName, "--operation", "read", "--operation", "describe", "--allow", "--principal", "User:user"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "acl", "--resource-name", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	aclFile := filepath.Join(t.TempDir(), "acls.yaml")
+	if err := os.WriteFile(aclFile, []byte(kafkaCtl.GetStdOut()), 0o600); err != nil {
+		t.Fatalf("failed to write acl file: %v", err)
+	}
+
+	// the acls that would be deleted are printed
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "acl", "-f", aclFile, "--validate-only"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertIntEquals(t, 3, len(kafkaCtl.GetStdOutLines()))
+
+	// but the acls still exist
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "acl", "--resource-name", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	acls, err := acl.FromYaml(kafkaCtl.GetStdOut())
+	if err != nil {
+		t.Fatalf("failed to read yaml: %v", err)
+	}
+	testutil.AssertIntEquals(t, 1, len(acls))
+	testutil.AssertIntEquals(t, 2, len(acls[0].Acls))
+}
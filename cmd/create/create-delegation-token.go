@@ -0,0 +1,34 @@
+package create
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/delegationtoken"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newCreateDelegationTokenCmd() *cobra.Command {
+
+	var flags delegationtoken.CreateDelegationTokenFlags
+
+	var cmdCreateDelegationToken = &cobra.Command{
+		Use:   "delegation-token",
+		Short: "create a delegation token",
+		Long: `create a delegation token for the authenticated user.
+The token id and hmac can be used as username and password of a context with sasl.tokenAuth.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&delegationtoken.Operation{}).CreateDelegationToken(flags)
+		},
+	}
+
+	cmdCreateDelegationToken.Flags().DurationVarP(&flags.MaxLifetime, "max-lifetime", "", 0, "max lifetime of the token (e.g. 24h). The default is the max lifetime configured on the broker.")
+	cmdCreateDelegationToken.Flags().StringSliceVarP(&flags.Renewers, "renewer", "r", nil, "principal that is allowed to renew the token (e.g. User:alice)")
+	cmdCreateDelegationToken.Flags().StringVarP(&flags.Owner, "owner", "", "", "principal to create the token for (requires Kafka 3.3+)")
+	cmdCreateDelegationToken.Flags().StringVarP(&flags.OutputFormat, "output", "o", "", "output format. One of: json|yaml")
+
+	return cmdCreateDelegationToken
+}
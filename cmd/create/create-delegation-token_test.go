@@ -0,0 +1,84 @@
+package create_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/delegationtoken"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestDelegationTokenLifecycleIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "delegation-token", "--max-lifetime", "2h", "--renewer", "User:admin", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	var token delegationtoken.DelegationToken
+	if err := json.Unmarshal([]byte(kafkaCtl.GetStdOut()), &token); err != nil {
+		t.Fatalf("failed to parse output: %v", err)
+	}
+
+	testutil.AssertEquals(t, "User:admin", token.Owner)
+	if token.TokenID == "" || token.HMAC == "" {
+		t.Fatalf("expected token id and hmac: %+v", token)
+	}
+	if token.MaxTime.After(time.Now().Add(2*time.Hour + time.Minute)) {
+		t.Fatalf("max time exceeds the max lifetime: %s", token.MaxTime)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "delegation-tokens"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	if !strings.Contains(kafkaCtl.GetStdOut(), token.TokenID) {
+		t.Fatalf("expected token %s in output: %s", token.TokenID, kafkaCtl.GetStdOut())
+	}
+	if strings.Contains(kafkaCtl.GetStdOut(), token.HMAC) {
+		t.Fatalf("hmac must not be printed in table output")
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("renew", "delegation-token", "--hmac", token.HMAC, "--renew-period", "1h"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "delegation token has been renewed", strings.Split(kafkaCtl.GetStdOut(), ",")[0])
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("expire", "delegation-token", "--hmac", token.HMAC); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "delegation token has been expired", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "delegation-tokens"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	if strings.Contains(kafkaCtl.GetStdOut(), token.TokenID) {
+		t.Fatalf("expected token %s to be expired: %s", token.TokenID, kafkaCtl.GetStdOut())
+	}
+}
+
+func TestRenewDelegationTokenWithInvalidHMACIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("renew", "delegation-token", "--hmac", "not base64!")
+	testutil.AssertErrorContains(t, "hmac has to be base64 encoded", err)
+}
@@ -196,6 +196,51 @@ func TestAlterUserIntegration(t *testing.T) {
 	}
 }
 
+func TestAlterUserRotateIntegration(t *testing.T) {
+	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	username := fmt.Sprintf("testrotate-%d", time.Now().Unix())
+
+	_, err := kafkaCtl.Execute("create", "user", username, "--password", "originalpass")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	_, err = kafkaCtl.Execute("alter", "user", username, "--rotate", "--generate")
+	if err != nil {
+		t.Fatalf("failed to rotate user: %v", err)
+	}
+
+	output := kafkaCtl.GetStdOut()
+	if !strings.Contains(output, fmt.Sprintf("user '%s' credentials have been rotated for SCRAM-SHA-256 and SCRAM-SHA-512", username)) {
+		t.Fatalf("expected user rotation message, got: %s", output)
+	}
+	if !strings.Contains(output, "generated password: ") {
+		t.Fatalf("expected generated password, got: %s", output)
+	}
+
+	_, err = kafkaCtl.Execute("describe", "user", username)
+	if err != nil {
+		t.Fatalf("failed to describe user after rotation: %v", err)
+	}
+
+	output = kafkaCtl.GetStdOut()
+	if !strings.Contains(output, "SCRAM-SHA-256") || !strings.Contains(output, "SCRAM-SHA-512") {
+		t.Fatalf("expected both mechanisms after rotation, got: %s", output)
+	}
+
+	_, err = kafkaCtl.Execute("alter", "user", username, "--password", "newpass", "--generate")
+	testutil.AssertErrorContains(t, "--generate can only be used together with --rotate", err)
+
+	// Clean up
+	for _, mechanism := range []string{"SCRAM-SHA-256", "SCRAM-SHA-512"} {
+		if _, err = kafkaCtl.Execute("delete", "user", username, "--mechanism", mechanism); err != nil {
+			t.Logf("cleanup failed (may be expected): %v", err)
+		}
+	}
+}
+
 func TestDeleteUserIntegration(t *testing.T) {
 	testutil.StartIntegrationTestWithContext(t, "sasl-admin")
 	kafkaCtl := testutil.CreateKafkaCtlCommand()
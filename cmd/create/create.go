@@ -6,12 +6,13 @@ func NewCreateCmd() *cobra.Command {
 
 	var cmdCreate = &cobra.Command{
 		Use:   "create",
-		Short: "create topics, consumerGroups, acls, users",
+		Short: "create topics, consumerGroups, acls, users, delegation tokens",
 	}
 
 	cmdCreate.AddCommand(newCreateTopicCmd())
 	cmdCreate.AddCommand(newCreateConsumerGroupCmd())
 	cmdCreate.AddCommand(newCreateACLCmd())
 	cmdCreate.AddCommand(newCreateUserCmd())
+	cmdCreate.AddCommand(newCreateDelegationTokenCmd())
 	return cmdCreate
 }
@@ -1,9 +1,11 @@
 package deletion
 
 import (
+	"github.com/deviceinsight/kafkactl/v5/cmd/validation"
 	"github.com/deviceinsight/kafkactl/v5/internal"
 	"github.com/deviceinsight/kafkactl/v5/internal/acl"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -18,10 +20,16 @@ func newDeleteACLCmd() *cobra.Command {
 		Args:    cobra.MaximumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
+				if flags.File != "" {
+					return errors.New("parameter --file is not supported when running in kubernetes")
+				}
 				return k8s.NewOperation().Run(cmd, args)
 			}
 			return (&acl.Operation{}).DeleteACL(flags)
 		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return validation.ValidateAtLeastOneRequiredFlag(cmd)
+		},
 	}
 
 	cmdDeleteACL.Flags().StringVarP(&flags.Operation, "operation", "o", "", "operation of acl")
@@ -35,14 +43,29 @@ func newDeleteACLCmd() *cobra.Command {
 	cmdDeleteACL.Flags().BoolVarP(&flags.Deny, "deny", "d", false, "acl of permissionType 'deny'")
 
 	// specify resource type
-	cmdDeleteACL.Flags().BoolVarP(&flags.Topics, "topics", "t", false, "delete acl for a topic")
-	cmdDeleteACL.Flags().BoolVarP(&flags.Groups, "groups", "g", false, "delete acl for a consumer group")
+	cmdDeleteACL.Flags().BoolVarP(&flags.Topics, "topics", "t", false, "delete acls of topics (use --topic to select the topic of a --role)")
+	cmdDeleteACL.Flags().BoolVarP(&flags.Groups, "groups", "g", false, "delete acls of consumer groups (use --group to select the group of a --role)")
 	cmdDeleteACL.Flags().BoolVarP(&flags.Cluster, "cluster", "c", false, "delete acl for the cluster")
 
+	// specify role
+	cmdDeleteACL.Flags().StringVarP(&flags.Role, "role", "", "", "delete the acls of a role. one of (producer, consumer, admin)")
+	cmdDeleteACL.Flags().StringVarP(&flags.Topic, "topic", "", "", "topic of the role (only with --role, use --topics otherwise)")
+	cmdDeleteACL.Flags().StringVarP(&flags.Group, "group", "", "", "consumer group of the role (only with --role, use --groups otherwise)")
+	cmdDeleteACL.Flags().StringVarP(&flags.TransactionalID, "transactional-id", "", "", "transactional id of the role")
+	cmdDeleteACL.Flags().BoolVarP(&flags.Idempotent, "idempotent", "", false, "delete the idempotent write acl of the role")
+
 	cmdDeleteACL.Flags().BoolVarP(&flags.ValidateOnly, "validate-only", "v", false, "validate only")
+	cmdDeleteACL.Flags().StringVarP(&flags.File, "file", "f", "", "delete all acls of a yaml or json file written by get acl")
 
-	_ = cmdDeleteACL.MarkFlagRequired("operation")
-	_ = cmdDeleteACL.MarkFlagRequired("pattern")
+	if err := validation.MarkFlagAtLeastOneRequired(cmdDeleteACL.Flags(), "operation"); err != nil {
+		panic(err)
+	}
+	if err := validation.MarkFlagAtLeastOneRequired(cmdDeleteACL.Flags(), "role"); err != nil {
+		panic(err)
+	}
+	if err := validation.MarkFlagAtLeastOneRequired(cmdDeleteACL.Flags(), "file"); err != nil {
+		panic(err)
+	}
 
 	_ = cmdDeleteACL.RegisterFlagCompletionFunc("operation", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"any", "all", "read", "write", "create", "delete", "alter", "describe", "clusteraction", "describeconfigs", "alterconfigs", "idempotentwrite"}, cobra.ShellCompDirectiveDefault
@@ -52,5 +75,9 @@ func newDeleteACLCmd() *cobra.Command {
 		return []string{"any", "match", "prefixed", "literal"}, cobra.ShellCompDirectiveDefault
 	})
 
+	_ = cmdDeleteACL.RegisterFlagCompletionFunc("role", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return acl.Roles, cobra.ShellCompDirectiveDefault
+	})
+
 	return cmdDeleteACL
 }
@@ -0,0 +1,33 @@
+package deletion
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/quota"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteQuotaCmd() *cobra.Command {
+
+	var flags quota.DeleteQuotaFlags
+
+	var cmdDeleteQuota = &cobra.Command{
+		Use:   "quota",
+		Short: "delete the client quota of a user and/or client-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&quota.Operation{}).DeleteQuota(flags)
+		},
+	}
+
+	cmdDeleteQuota.Flags().StringVarP(&flags.User, "user", "u", "", "user of the quota")
+	cmdDeleteQuota.Flags().StringVarP(&flags.ClientID, "client-id", "c", "", "client-id of the quota")
+	cmdDeleteQuota.Flags().BoolVarP(&flags.DefaultUser, "default-user", "", false, "quota of the default user")
+	cmdDeleteQuota.Flags().BoolVarP(&flags.DefaultClientID, "default-client-id", "", false, "quota of the default client-id")
+	cmdDeleteQuota.Flags().StringSliceVarP(&flags.Keys, "quota", "q", nil, "only delete the given quotas (e.g. producer_byte_rate), default: all")
+
+	return cmdDeleteQuota
+}
@@ -9,18 +9,26 @@ import (
 
 func newDeleteTopicCmd() *cobra.Command {
 
+	var flags topic.DeleteTopicsFlags
+
 	var cmdDeleteTopic = &cobra.Command{
-		Use:   "topic TOPIC",
+		Use:   "topic [TOPIC...]",
 		Short: "delete a topic",
-		Args:  cobra.MinimumNArgs(1),
+		Long: `delete a topic.
+Multiple topics can be deleted by names, glob patterns (e.g. 'orders-*') or a regular expression (--pattern).
+Topics selected by patterns are listed and have to be confirmed.`,
+		Args: topic.TopicsOrPattern(&flags.SelectTopicsFlags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
 				return k8s.NewOperation().Run(cmd, args)
 			}
-			return (&topic.Operation{}).DeleteTopics(args)
+			return (&topic.Operation{}).DeleteTopics(args, flags)
 		},
 		ValidArgsFunction: topic.CompleteTopicNames,
 	}
 
+	cmdDeleteTopic.Flags().StringVarP(&flags.Pattern, "pattern", "", "", "regular expression the topic names have to match")
+	cmdDeleteTopic.Flags().BoolVarP(&flags.ExcludeInternal, "exclude-internal", "", false, "exclude internal topics (starting with __)")
+
 	return cmdDeleteTopic
 }
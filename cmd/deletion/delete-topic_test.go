@@ -81,6 +81,125 @@ func TestDeleteTopicAutoCompletionIntegration(t *testing.T) {
 	testutil.AssertContains(t, topicName3, outputLines)
 }
 
+func TestDeleteTopicsByPatternIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("delete-topics-pattern")
+
+	topicName1 := testutil.CreateTopic(t, prefix+"-a")
+	topicName2 := testutil.CreateTopic(t, prefix+"-b")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", "--pattern", prefix+"-.*", "--yes"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{fmt.Sprintf("topic deleted: %s", topicName1),
+		fmt.Sprintf("topic deleted: %s", topicName2)}, kafkaCtl.GetStdOutLines())
+
+	verifyTopicDeleted(t, kafkaCtl, topicName1)
+	verifyTopicDeleted(t, kafkaCtl, topicName2)
+}
+
+func TestDeleteTopicsByGlobRequiresConfirmationIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("delete-topics-glob")
+
+	topicName := testutil.CreateTopic(t, prefix)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", prefix+"*"); err != nil {
+		testutil.AssertErrorContains(t, "confirmation required", err)
+	} else {
+		t.Fatalf("expected delete without --yes to fail")
+	}
+
+	testutil.VerifyTopicExists(t, topicName)
+}
+
+func TestDeleteTopicsWithoutTopicOrPatternIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic"); err != nil {
+		testutil.AssertErrorContains(t, "requires at least one topic or --pattern", err)
+	} else {
+		t.Fatalf("expected delete without topic to fail")
+	}
+}
+
+func TestDeleteTopicInReadOnlyContextIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "delete-read-only")
+
+	testutil.SwitchContext("read-only")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", topicName, "--yes"); err != nil {
+		testutil.AssertErrorContains(t, "context 'read-only' is read-only: delete topic "+topicName+" is not allowed", err)
+	} else {
+		t.Fatalf("expected delete in read-only context to fail")
+	}
+
+	testutil.VerifyTopicExists(t, topicName)
+}
+
+func TestDeleteProtectedTopicIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "protected-topic")
+
+	testutil.SwitchContext("protected")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", topicName, "--yes"); err != nil {
+		testutil.AssertErrorContains(t, "topic '"+topicName+"' is protected in context 'protected'", err)
+	} else {
+		t.Fatalf("expected delete of protected topic to fail")
+	}
+
+	testutil.VerifyTopicExists(t, topicName)
+}
+
+func TestDeleteTopicRequiresConfirmationIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "delete-confirmation")
+
+	testutil.SwitchContext("protected")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", topicName); err != nil {
+		testutil.AssertErrorContains(t, "confirmation required but no terminal available", err)
+	} else {
+		t.Fatalf("expected delete without confirmation to fail")
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("delete", "topic", topicName, "--yes"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, fmt.Sprintf("topic deleted: %s", topicName), kafkaCtl.GetStdOut())
+
+	verifyTopicDeleted(t, kafkaCtl, topicName)
+}
+
 func verifyTopicDeleted(t *testing.T, kafkaCtl testutil.KafkaCtlTestCommand, topicName string) {
 
 	checkTopicDeleted := func(_ uint) error {
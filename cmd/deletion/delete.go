@@ -17,5 +17,6 @@ func NewDeleteCmd() *cobra.Command {
 	cmdDelete.AddCommand(newDeleteACLCmd())
 	cmdDelete.AddCommand(newDeleteRecordsCmd())
 	cmdDelete.AddCommand(newDeleteUserCmd())
+	cmdDelete.AddCommand(newDeleteQuotaCmd())
 	return cmdDelete
 }
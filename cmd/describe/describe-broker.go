@@ -26,6 +26,7 @@ func newDescribeBrokerCmd() *cobra.Command {
 	}
 
 	cmdDescribeBroker.Flags().BoolVarP(&flags.AllConfigs, "all-configs", "a", false, "print all configs including defaults")
+	cmdDescribeBroker.Flags().BoolVarP(&flags.LogDirs, "log-dirs", "", false, "print the log dirs of the broker with the size of each partition replica")
 	cmdDescribeBroker.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml|wide")
 
 	return cmdDescribeBroker
@@ -112,3 +112,40 @@ func TestDescribeBrokerAutoCompletionIntegration(t *testing.T) {
 	testutil.AssertContains(t, "102", outputLines)
 	testutil.AssertContains(t, "103", outputLines)
 }
+
+func TestDescribeBrokerLogDirsIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "describe-broker-log-dirs-", "--replication-factor", "3")
+	testutil.ProduceMessage(t, topicName, "key", "value", 0, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+	kafkaCtl.Verbose = false
+
+	if _, err := kafkaCtl.Execute("describe", "broker", "101", "--log-dirs", "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	describedBroker, err := broker.FromYaml(kafkaCtl.GetStdOut())
+	if err != nil {
+		t.Fatalf("could not convert output to broker yaml: %v", err)
+	}
+
+	if len(describedBroker.LogDirs) == 0 {
+		t.Fatalf("expected at least one log dir")
+	}
+
+	found := false
+	for _, logDir := range describedBroker.LogDirs {
+		for _, replica := range logDir.Replicas {
+			if replica.Topic == topicName && replica.Partition == 0 && replica.Size > 0 {
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		t.Fatalf("expected replica of topic %s in log dirs: %v", topicName, describedBroker.LogDirs)
+	}
+}
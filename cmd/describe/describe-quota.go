@@ -0,0 +1,33 @@
+package describe
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/quota"
+	"github.com/spf13/cobra"
+)
+
+func newDescribeQuotaCmd() *cobra.Command {
+
+	var flags quota.DescribeQuotaFlags
+
+	var cmdDescribeQuota = &cobra.Command{
+		Use:   "quota",
+		Short: "describe the client quota of a user and/or client-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&quota.Operation{}).DescribeQuota(flags)
+		},
+	}
+
+	cmdDescribeQuota.Flags().StringVarP(&flags.User, "user", "u", "", "user of the quota")
+	cmdDescribeQuota.Flags().StringVarP(&flags.ClientID, "client-id", "c", "", "client-id of the quota")
+	cmdDescribeQuota.Flags().BoolVarP(&flags.DefaultUser, "default-user", "", false, "quota of the default user")
+	cmdDescribeQuota.Flags().BoolVarP(&flags.DefaultClientID, "default-client-id", "", false, "quota of the default client-id")
+	cmdDescribeQuota.Flags().StringVarP(&flags.OutputFormat, "output", "o", "", "output format. One of: json|yaml")
+
+	return cmdDescribeQuota
+}
@@ -12,14 +12,16 @@ func newDescribeTopicCmd() *cobra.Command {
 	var flags topic.DescribeTopicFlags
 
 	var cmdDescribeTopic = &cobra.Command{
-		Use:   "topic TOPIC",
+		Use:   "topic [TOPIC...]",
 		Short: "describe a topic",
-		Args:  cobra.MinimumNArgs(1),
+		Long: `describe a topic.
+Multiple topics can be described by names, glob patterns (e.g. 'orders-*') or a regular expression (--pattern).`,
+		Args: topic.TopicsOrPattern(&flags.SelectTopicsFlags),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
 				return k8s.NewOperation().Run(cmd, args)
 			}
-			return (&topic.Operation{}).DescribeTopic(args[0], flags)
+			return (&topic.Operation{}).DescribeTopics(args, flags)
 		},
 		ValidArgsFunction: topic.CompleteTopicNames,
 	}
@@ -27,6 +29,8 @@ func newDescribeTopicCmd() *cobra.Command {
 	cmdDescribeTopic.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml|wide")
 	cmdDescribeTopic.Flags().BoolVarP(&flags.AllConfigs, "all-configs", "a", false, "print all configs including defaults")
 	cmdDescribeTopic.Flags().BoolVarP(&flags.SkipEmptyPartitions, "skip-empty", "s", false, "show only partitions that have a messages")
+	cmdDescribeTopic.Flags().StringVarP(&flags.Pattern, "pattern", "", "", "regular expression the topic names have to match")
+	cmdDescribeTopic.Flags().BoolVarP(&flags.ExcludeInternal, "exclude-internal", "", false, "exclude internal topics (starting with __)")
 
 	return cmdDescribeTopic
 }
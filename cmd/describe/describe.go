@@ -15,6 +15,7 @@ func NewDescribeCmd() *cobra.Command {
 	cmdDescribe.AddCommand(newDescribeConsumerGroupCmd())
 	cmdDescribe.AddCommand(newDescribeBrokerCmd())
 	cmdDescribe.AddCommand(newDescribeUserCmd())
+	cmdDescribe.AddCommand(newDescribeQuotaCmd())
 
 	return cmdDescribe
 }
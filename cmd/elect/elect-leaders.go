@@ -0,0 +1,45 @@
+package elect
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/partition"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/spf13/cobra"
+)
+
+func newElectLeadersCmd() *cobra.Command {
+
+	var flags partition.ElectLeadersFlags
+
+	var cmdElectLeaders = &cobra.Command{
+		Use:   "leaders",
+		Short: "elect the preferred leader (or an unclean leader) for partitions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&partition.Operation{}).ElectLeaders(flags)
+		},
+	}
+
+	cmdElectLeaders.Flags().StringArrayVarP(&flags.Topics, "topic", "t", nil, "topic to elect leaders for (glob patterns are supported)")
+	cmdElectLeaders.Flags().Int32SliceVarP(&flags.Partitions, "partition", "p", nil, "partitions of the topic to elect leaders for")
+	cmdElectLeaders.Flags().StringVarP(&flags.Type, "type", "", "preferred", "election type. One of: preferred|unclean")
+	cmdElectLeaders.Flags().BoolVarP(&flags.All, "all", "a", false, "elect leaders for all topics")
+	cmdElectLeaders.Flags().BoolVarP(&flags.DryRun, "dry-run", "", false, "only list the partitions that need a leader election")
+	cmdElectLeaders.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	if err := cmdElectLeaders.RegisterFlagCompletionFunc("topic", topic.CompleteTopicNames); err != nil {
+		panic(err)
+	}
+
+	if err := cmdElectLeaders.RegisterFlagCompletionFunc("type", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"preferred", "unclean"}, cobra.ShellCompDirectiveDefault
+	}); err != nil {
+		panic(err)
+	}
+
+	return cmdElectLeaders
+}
@@ -0,0 +1,71 @@
+package elect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+)
+
+func TestElectPreferredLeadersIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "elect-leaders-", "--partitions", "2", "--replication-factor", "2")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("describe", "topic", topicName, "-o", "yaml"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	describedTopic, err := topic.FromYaml(kafkaCtl.GetStdOut())
+	if err != nil {
+		t.Fatalf("failed to read yaml: %v", err)
+	}
+
+	replicas := describedTopic.Partitions[0].Replicas
+
+	// swap the replicas of partition 0, so that the leader is no longer the preferred leader
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("alter", "partition", topicName, "0", "--replicas", fmt.Sprintf("%d,%d", replicas[1], replicas[0])); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("elect", "leaders", "--topic", topicName, "--dry-run"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, topicName, kafkaCtl.GetStdOut())
+	testutil.AssertContainNoSubstring(t, "RESULT", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("elect", "leaders", "--topic", topicName, "--partition", "0"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, "elected", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("elect", "leaders", "--topic", topicName, "--dry-run"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "no partitions need a leader election", kafkaCtl.GetStdOut())
+}
+
+func TestElectLeadersRequiresTopicOrAllIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("elect", "leaders")
+	testutil.AssertErrorContains(t, "either --topic or --all is required", err)
+}
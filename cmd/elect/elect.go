@@ -0,0 +1,15 @@
+package elect
+
+import "github.com/spf13/cobra"
+
+func NewElectCmd() *cobra.Command {
+
+	var cmdElect = &cobra.Command{
+		Use:   "elect",
+		Short: "elect partition leaders",
+	}
+
+	cmdElect.AddCommand(newElectLeadersCmd())
+
+	return cmdElect
+}
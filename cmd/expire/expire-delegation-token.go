@@ -0,0 +1,34 @@
+package expire
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/delegationtoken"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newExpireDelegationTokenCmd() *cobra.Command {
+
+	var flags delegationtoken.ExpireDelegationTokenFlags
+
+	var cmdExpireDelegationToken = &cobra.Command{
+		Use:   "delegation-token",
+		Short: "expire a delegation token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&delegationtoken.Operation{}).ExpireDelegationToken(flags)
+		},
+	}
+
+	cmdExpireDelegationToken.Flags().StringVarP(&flags.HMAC, "hmac", "", "", "base64 encoded hmac of the token (may be a credential reference, e.g. env:TOKEN_HMAC)")
+	cmdExpireDelegationToken.Flags().DurationVarP(&flags.ExpiryPeriod, "expiry-period", "", 0, "expire the token after the given period (e.g. 1h). The default is to expire it immediately.")
+
+	if err := cmdExpireDelegationToken.MarkFlagRequired("hmac"); err != nil {
+		panic(err)
+	}
+
+	return cmdExpireDelegationToken
+}
@@ -0,0 +1,15 @@
+package expire
+
+import "github.com/spf13/cobra"
+
+func NewExpireCmd() *cobra.Command {
+
+	var cmdExpire = &cobra.Command{
+		Use:   "expire",
+		Short: "expire delegation tokens",
+	}
+
+	cmdExpire.AddCommand(newExpireDelegationTokenCmd())
+
+	return cmdExpire
+}
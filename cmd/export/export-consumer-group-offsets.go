@@ -0,0 +1,37 @@
+package export
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroupoffsets"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newExportConsumerGroupOffsetsCmd() *cobra.Command {
+
+	var flags consumergroupoffsets.ExportConsumerGroupOffsetsFlags
+
+	var cmdExportOffsets = &cobra.Command{
+		Use:     "consumer-group-offsets GROUP...",
+		Aliases: []string{"cgo", "offsets"},
+		Short:   "export committed offsets of consumer groups to a snapshot file",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				if flags.File != "" {
+					return errors.New("parameter --file is not supported when running in kubernetes")
+				}
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&consumergroupoffsets.ConsumerGroupOffsetOperation{}).ExportConsumerGroupOffsets(args, flags)
+		},
+		ValidArgsFunction: consumergroups.CompleteConsumerGroups,
+	}
+
+	cmdExportOffsets.Flags().StringVarP(&flags.File, "file", "f", "", "snapshot file to write (yaml or json). The snapshot is printed as yaml if no file is given")
+	cmdExportOffsets.Flags().BoolVarP(&flags.WithTimestamps, "with-timestamps", "", false, "include the timestamp of the record at each offset (required to translate offsets on import)")
+
+	return cmdExportOffsets
+}
@@ -0,0 +1,79 @@
+package export_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroupoffsets"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+	"gopkg.in/yaml.v2"
+)
+
+func TestExportAndImportConsumerGroupOffsetsIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "export-cgo")
+
+	group := testutil.CreateConsumerGroup(t, "export-cgo", topicName)
+
+	testutil.ProduceMessage(t, topicName, "test-key", "a", 0, 0)
+	testutil.ProduceMessage(t, topicName, "test-key", "b", 0, 1)
+	testutil.ProduceMessage(t, topicName, "test-key", "c", 0, 2)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--offset", "1", "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 1)
+
+	snapshotFile := filepath.Join(t.TempDir(), "snapshot.yaml")
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("export", "consumer-group-offsets", group, "-f", snapshotFile, "--with-timestamps"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "offsets of 1 consumer-groups exported to "+snapshotFile, kafkaCtl.GetStdOut())
+
+	content, err := os.ReadFile(snapshotFile)
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+
+	var snapshot consumergroupoffsets.Snapshot
+	if err := yaml.Unmarshal(content, &snapshot); err != nil {
+		t.Fatalf("failed to parse snapshot: %v", err)
+	}
+
+	testutil.AssertIntEquals(t, 1, len(snapshot.ConsumerGroups))
+	testutil.AssertEquals(t, group, snapshot.ConsumerGroups[0].Name)
+	testutil.AssertEquals(t, topicName, snapshot.ConsumerGroups[0].Topics[0].Name)
+
+	partition := snapshot.ConsumerGroups[0].Topics[0].Partitions[0]
+	testutil.AssertIntEquals(t, 1, int(partition.Offset))
+	if partition.Timestamp == nil {
+		t.Fatalf("expected timestamp of record at offset 1")
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--newest", "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 3)
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("import", "consumer-group-offsets", "-f", snapshotFile); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "consumer-group offsets imported: "+group, kafkaCtl.GetStdOut())
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 1)
+}
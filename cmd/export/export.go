@@ -0,0 +1,15 @@
+package export
+
+import "github.com/spf13/cobra"
+
+func NewExportCmd() *cobra.Command {
+
+	var cmdExport = &cobra.Command{
+		Use:   "export",
+		Short: "export consumer-group-offsets",
+	}
+
+	cmdExport.AddCommand(newExportConsumerGroupOffsetsCmd())
+
+	return cmdExport
+}
@@ -0,0 +1,48 @@
+package find
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/spf13/cobra"
+)
+
+func NewFindCmd() *cobra.Command {
+	var flags consume.FindFlags
+
+	cmdFind := &cobra.Command{
+		Use:   "find TOPIC",
+		Short: "find messages with a given key",
+		Long: `find messages with a given key.
+The partition of the key is computed with the configured partitioner, so that only this partition has to be scanned.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&consume.Operation{}).Find(args[0], flags)
+		},
+		ValidArgsFunction: topic.CompleteTopicNames,
+	}
+
+	cmdFind.Flags().StringVarP(&flags.Key, "key", "k", "", "key of the messages to find")
+	cmdFind.Flags().StringVarP(&flags.KeyEncoding, "key-encoding", "", flags.KeyEncoding, "key encoding (none by default). One of: none|hex|base64")
+	cmdFind.Flags().StringVarP(&flags.Partitioner, "partitioner", "P", "", "the partitioning scheme used to produce the messages. Can be `murmur2`, `hash` or `hash-ref`. (default is murmur2)")
+	cmdFind.Flags().StringVarP(&flags.FromTimestamp, "from-timestamp", "", "", "only scan messages from offset of given timestamp")
+	cmdFind.Flags().StringVarP(&flags.ToTimestamp, "to-timestamp", "", "", "only scan messages till offset of given timestamp")
+	cmdFind.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+	cmdFind.Flags().StringVarP(&flags.EncodeValue, "value-encoding", "", flags.EncodeValue, "value encoding (auto-detected by default). One of: none|hex|base64")
+	cmdFind.Flags().StringSliceVarP(&flags.ProtoFiles, "proto-file", "", flags.ProtoFiles, "additional protobuf description file for searching message description")
+	cmdFind.Flags().StringSliceVarP(&flags.ProtoImportPaths, "proto-import-path", "", flags.ProtoImportPaths, "additional path to search files listed in proto 'import' directive")
+	cmdFind.Flags().StringSliceVarP(&flags.ProtosetFiles, "protoset-file", "", flags.ProtosetFiles, "additional compiled protobuf description file for searching message description")
+	cmdFind.Flags().StringVarP(&flags.KeyProtoType, "key-proto-type", "", flags.KeyProtoType, "key protobuf message type")
+	cmdFind.Flags().StringVarP(&flags.ValueProtoType, "value-proto-type", "", flags.ValueProtoType, "value protobuf message type")
+	cmdFind.Flags().StringVarP(&flags.IsolationLevel, "isolation-level", "i", "", "isolationLevel to use. One of: ReadUncommitted|ReadCommitted")
+
+	if err := cmdFind.MarkFlagRequired("key"); err != nil {
+		panic(err)
+	}
+
+	return cmdFind
+}
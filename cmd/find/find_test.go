@@ -0,0 +1,76 @@
+package find_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestFindMessagesByKeyIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "find-topic", "--partitions", "2")
+
+	testutil.ProduceMessage(t, topicName, "test-key", "value-a", 1, 0)
+	testutil.ProduceMessageOnPartition(t, topicName, "other-key", "value-b", 1, 1)
+	testutil.ProduceMessage(t, topicName, "test-key", "value-c", 1, 2)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("find", topicName, "--key", "test-key", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	var messages []consume.Message
+	if err := json.Unmarshal([]byte(kafkaCtl.GetStdOut()), &messages); err != nil {
+		t.Fatalf("failed to parse output: %v", err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+
+	testutil.AssertIntEquals(t, 1, int(messages[0].Partition))
+	testutil.AssertIntEquals(t, 0, int(messages[0].Offset))
+	testutil.AssertEquals(t, "value-a", *messages[0].Value)
+	testutil.AssertIntEquals(t, 2, int(messages[1].Offset))
+	testutil.AssertEquals(t, "value-c", *messages[1].Value)
+}
+
+func TestFindMessagesByKeyWithTableOutputIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "find-topic", "--partitions", "2")
+
+	testutil.ProduceMessage(t, topicName, "test-key", "value-a", 1, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("find", topicName, "--key", "test-key"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	outputLines := kafkaCtl.GetStdOutLines()
+
+	testutil.AssertIntEquals(t, 2, len(outputLines))
+	testutil.AssertEquals(t, "PARTITION|OFFSET|TIMESTAMP|VALUE", outputLines[0])
+	testutil.AssertContainSubstring(t, "value-a", outputLines[1])
+}
+
+func TestFindMessagesByUnknownKeyIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "find-topic", "--partitions", "2")
+
+	testutil.ProduceMessage(t, topicName, "test-key", "value-a", 1, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("find", topicName, "--key", "unknown-key")
+	testutil.AssertErrorContains(t, "no message with key \"unknown-key\" found", err)
+}
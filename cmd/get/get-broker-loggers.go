@@ -0,0 +1,31 @@
+package get
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/broker"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newGetBrokerLoggersCmd() *cobra.Command {
+
+	var flags broker.GetBrokerLoggersFlags
+
+	var cmdGetBrokerLoggers = &cobra.Command{
+		Use:   "broker-loggers BROKER",
+		Short: "list the log levels of the loggers of a broker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&broker.Operation{}).GetBrokerLoggers(args[0], flags)
+		},
+		ValidArgsFunction: broker.CompleteBrokerIDs,
+	}
+
+	cmdGetBrokerLoggers.Flags().StringVarP(&flags.Filter, "filter", "", "", "glob pattern the logger names have to match (e.g. 'kafka.*')")
+	cmdGetBrokerLoggers.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	return cmdGetBrokerLoggers
+}
@@ -0,0 +1,26 @@
+package get_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestGetBrokerLoggersIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "broker-loggers", "101", "--filter", "kafka.*"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	outputLines := kafkaCtl.GetStdOutLines()
+
+	testutil.AssertEquals(t, "LOGGER|LEVEL", outputLines[0])
+
+	for _, line := range outputLines[1:] {
+		testutil.AssertContainSubstring(t, "kafka.", line)
+	}
+}
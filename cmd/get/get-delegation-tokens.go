@@ -0,0 +1,31 @@
+package get
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/delegationtoken"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newGetDelegationTokensCmd() *cobra.Command {
+
+	var flags delegationtoken.GetDelegationTokensFlags
+
+	var cmdGetDelegationTokens = &cobra.Command{
+		Use:     "delegation-tokens",
+		Aliases: []string{"delegation-token"},
+		Short:   "get delegation tokens",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&delegationtoken.Operation{}).GetDelegationTokens(flags)
+		},
+	}
+
+	cmdGetDelegationTokens.Flags().StringSliceVarP(&flags.Owners, "owner", "", nil, "only get tokens of the given owners (e.g. User:alice)")
+	cmdGetDelegationTokens.Flags().StringVarP(&flags.OutputFormat, "output", "o", "", "output format. One of: json|yaml")
+
+	return cmdGetDelegationTokens
+}
@@ -0,0 +1,41 @@
+package get
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/spf13/cobra"
+)
+
+func newGetOffsetsCmd() *cobra.Command {
+
+	var flags consume.GetOffsetsFlags
+
+	var cmdGetOffsets = &cobra.Command{
+		Use:   "offsets TOPIC...",
+		Short: "get oldest and newest offsets of topics",
+		Long: `get oldest and newest offsets of topics.
+Topics can be given as glob patterns, e.g. 'orders-*'.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&consume.Operation{}).GetOffsets(args, flags)
+		},
+		ValidArgsFunction: topic.CompleteTopicNames,
+	}
+
+	cmdGetOffsets.Flags().IntSliceVarP(&flags.Partitions, "partitions", "p", flags.Partitions, "partitions to get offsets for. The default is all partitions.")
+	cmdGetOffsets.Flags().StringArrayVarP(&flags.Offsets, "offset", "", flags.Offsets, "offsets in format `partition=offset` to get the number of remaining messages for")
+	cmdGetOffsets.Flags().StringVarP(&flags.AtTimestamp, "at-timestamp", "", "", "additionally get the offsets for the given timestamp")
+	cmdGetOffsets.Flags().StringVarP(&flags.AtTimestamp, "timestamp", "", "", "alias for --at-timestamp")
+	cmdGetOffsets.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	if err := cmdGetOffsets.Flags().MarkHidden("timestamp"); err != nil {
+		panic(err)
+	}
+
+	return cmdGetOffsets
+}
@@ -0,0 +1,132 @@
+package get_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestGetOffsetsIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "get-offsets", "--partitions", "2")
+
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "a", 0, 0)
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "b", 0, 1)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "offsets", topicName); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{
+		"TOPIC|PARTITION|OLDEST_OFFSET|NEWEST_OFFSET|MESSAGES",
+		fmt.Sprintf("%s|0|0|2|2", topicName),
+		fmt.Sprintf("%s|1|0|0|0", topicName),
+	}, kafkaCtl.GetStdOutLines())
+}
+
+func TestGetOffsetsForTimestampIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "get-offsets", "--partitions", "2")
+
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "a", 0, 0)
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "b", 1, 0)
+
+	time.Sleep(10 * time.Millisecond)
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	time.Sleep(10 * time.Millisecond)
+
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "c", 0, 1)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "offsets", topicName, "--at-timestamp", timestamp); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{
+		"TOPIC|PARTITION|OLDEST_OFFSET|NEWEST_OFFSET|OFFSET_AT_TIME|MESSAGES",
+		fmt.Sprintf("%s|0|0|2|1|2", topicName),
+		fmt.Sprintf("%s|1|0|1|1|1", topicName),
+	}, kafkaCtl.GetStdOutLines())
+}
+
+func TestGetOffsetsWithPatternAndPartitionsIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("get-offsets-pattern")
+
+	topicA := testutil.CreateTopic(t, prefix, "--partitions", "2")
+	topicB := testutil.CreateTopic(t, prefix, "--partitions", "2")
+
+	testutil.ProduceMessageOnPartition(t, topicA, "key", "a", 1, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "offsets", prefix+"*", "--partitions", "1", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	var offsets []consume.PartitionOffsets
+	if err := json.Unmarshal([]byte(kafkaCtl.GetStdOut()), &offsets); err != nil {
+		t.Fatalf("failed to parse output: %v", err)
+	}
+
+	messagesPerTopic := make(map[string]int64)
+	for _, offset := range offsets {
+		testutil.AssertIntEquals(t, 1, int(offset.Partition))
+		messagesPerTopic[offset.Topic] = offset.Messages
+	}
+
+	testutil.AssertIntEquals(t, 2, len(messagesPerTopic))
+	testutil.AssertIntEquals(t, 1, int(messagesPerTopic[topicA]))
+	testutil.AssertIntEquals(t, 0, int(messagesPerTopic[topicB]))
+}
+
+func TestGetOffsetsWithOffsetsIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "get-offsets", "--partitions", "3")
+
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "a", 0, 0)
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "b", 0, 1)
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "c", 0, 2)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "offsets", topicName, "--offset", "0=1"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{
+		"TOPIC|PARTITION|OLDEST_OFFSET|NEWEST_OFFSET|OFFSET|REMAINING|MESSAGES",
+		fmt.Sprintf("%s|0|0|3|1|2|3", topicName),
+	}, kafkaCtl.GetStdOutLines())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("get", "offsets", topicName, "--offset", "0:1")
+	testutil.AssertErrorContains(t, "offset parameter has wrong format", err)
+}
+
+func TestGetOffsetsOfUnknownTopicIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("get", "offsets", "unknown-topic-for-offsets")
+	testutil.AssertErrorContains(t, "topic 'unknown-topic-for-offsets' does not exist", err)
+}
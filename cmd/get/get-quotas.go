@@ -0,0 +1,34 @@
+package get
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/quota"
+	"github.com/spf13/cobra"
+)
+
+func newGetQuotasCmd() *cobra.Command {
+
+	var flags quota.GetQuotasFlags
+
+	var cmdGetQuotas = &cobra.Command{
+		Use:     "quotas",
+		Aliases: []string{"quota"},
+		Short:   "get client quotas",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&quota.Operation{}).GetQuotas(flags)
+		},
+	}
+
+	cmdGetQuotas.Flags().StringVarP(&flags.User, "user", "u", "", "only quotas of the user")
+	cmdGetQuotas.Flags().StringVarP(&flags.ClientID, "client-id", "c", "", "only quotas of the client-id")
+	cmdGetQuotas.Flags().BoolVarP(&flags.DefaultUser, "default-user", "", false, "only quotas of the default user")
+	cmdGetQuotas.Flags().BoolVarP(&flags.DefaultClientID, "default-client-id", "", false, "only quotas of the default client-id")
+	cmdGetQuotas.Flags().StringVarP(&flags.OutputFormat, "output", "o", "", "output format. One of: json|yaml")
+
+	return cmdGetQuotas
+}
@@ -0,0 +1,34 @@
+package get
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/spf13/cobra"
+)
+
+func newGetTopicSizesCmd() *cobra.Command {
+
+	var flags topic.GetTopicSizesFlags
+
+	var cmdGetTopicSizes = &cobra.Command{
+		Use:   "topic-sizes [TOPIC...]",
+		Short: "list the disk usage of topics",
+		Long: `list the disk usage of topics summed up over all partition replicas, with totals per broker.
+Topics can be filtered by names, glob patterns (e.g. 'orders-*') or a regular expression (--pattern).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&topic.Operation{}).GetTopicSizes(args, flags)
+		},
+		ValidArgsFunction: topic.CompleteTopicNames,
+	}
+
+	cmdGetTopicSizes.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml|wide")
+	cmdGetTopicSizes.Flags().StringVarP(&flags.SortBy, "sort-by", "", "size", "sort topics by: size|name")
+	cmdGetTopicSizes.Flags().StringVarP(&flags.Pattern, "pattern", "", "", "regular expression the topic names have to match")
+	cmdGetTopicSizes.Flags().BoolVarP(&flags.ExcludeInternal, "exclude-internal", "", false, "exclude internal topics (starting with __)")
+
+	return cmdGetTopicSizes
+}
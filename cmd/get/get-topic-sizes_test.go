@@ -0,0 +1,37 @@
+package get_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestGetTopicSizesIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "get-topic-sizes-", "--partitions", "2", "--replication-factor", "2")
+	testutil.ProduceMessageOnPartition(t, topicName, "key", "value", 1, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topic-sizes", topicName); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	outputLines := kafkaCtl.GetStdOutLines()
+
+	testutil.AssertEquals(t, "TOPIC|PARTITIONS|REPLICAS|SIZE", outputLines[0])
+	testutil.AssertContainSubstring(t, topicName+"|2|4|", outputLines[1])
+	testutil.AssertContainSubstring(t, "BROKER|SIZE", kafkaCtl.GetStdOut())
+	testutil.AssertContainSubstring(t, "TOTAL|", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topic-sizes", topicName, "-o", "wide"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	// the replicas of partition 1 contain the message and are listed first
+	testutil.AssertContainSubstring(t, topicName+"|1|", kafkaCtl.GetStdOutLines()[1])
+}
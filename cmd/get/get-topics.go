@@ -12,17 +12,21 @@ func newGetTopicsCmd() *cobra.Command {
 	var flags topic.GetTopicsFlags
 
 	var cmdGetTopics = &cobra.Command{
-		Use:   "topics",
+		Use:   "topics [TOPIC...]",
 		Short: "list available topics",
+		Long: `list available topics.
+Topics can be filtered by names, glob patterns (e.g. 'orders-*') or a regular expression (--pattern).`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
 				return k8s.NewOperation().Run(cmd, args)
 			}
-			return (&topic.Operation{}).GetTopics(flags)
+			return (&topic.Operation{}).GetTopics(args, flags)
 		},
 	}
 
 	cmdGetTopics.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml|wide|compact")
+	cmdGetTopics.Flags().StringVarP(&flags.Pattern, "pattern", "", "", "regular expression the topic names have to match")
+	cmdGetTopics.Flags().BoolVarP(&flags.ExcludeInternal, "exclude-internal", "", false, "exclude internal topics (starting with __)")
 
 	return cmdGetTopics
 }
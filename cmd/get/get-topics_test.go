@@ -2,6 +2,7 @@ package get_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
@@ -49,3 +50,47 @@ func TestGetTopicsShowsReplicationFactorOfTopicsIntegration(t *testing.T) {
 	testutil.AssertContains(t, fmt.Sprintf("%s|1|1", topicA), outputLines)
 	testutil.AssertContains(t, fmt.Sprintf("%s|1|2", topicB), outputLines)
 }
+
+func TestGetTopicsByPatternIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	prefix := testutil.GetPrefixedName("get-topics-pattern")
+
+	topicA := testutil.CreateTopic(t, prefix+"-a")
+	topicB := testutil.CreateTopic(t, prefix+"-b")
+	testutil.CreateTopic(t, prefix+"-c")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topics", "-o", "compact", "--pattern", prefix+"-(a|b)-.*"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{topicA, topicB}, kafkaCtl.GetStdOutLines())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topics", "-o", "compact", prefix+"-a-*"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertArraysEquals(t, []string{topicA}, kafkaCtl.GetStdOutLines())
+}
+
+func TestGetTopicsExcludeInternalIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topics", "-o", "compact", "--exclude-internal"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	for _, line := range kafkaCtl.GetStdOutLines() {
+		if strings.HasPrefix(line, "__") {
+			t.Fatalf("internal topic should be excluded: %s", line)
+		}
+	}
+}
@@ -9,7 +9,7 @@ func NewGetCmd() *cobra.Command {
 	var cmdGet = &cobra.Command{
 		Use:     "get",
 		Aliases: []string{"list"},
-		Short:   "get info about topics, consumerGroups, acls, brokers, users",
+		Short:   "get info about topics, consumerGroups, acls, brokers, users, offsets, delegation tokens",
 	}
 
 	cmdGet.AddCommand(newGetTopicsCmd())
@@ -17,6 +17,11 @@ func NewGetCmd() *cobra.Command {
 	cmdGet.AddCommand(newGetACLCmd())
 	cmdGet.AddCommand(newGetBrokersCmd())
 	cmdGet.AddCommand(newGetUsersCmd())
+	cmdGet.AddCommand(newGetOffsetsCmd())
+	cmdGet.AddCommand(newGetTopicSizesCmd())
+	cmdGet.AddCommand(newGetBrokerLoggersCmd())
+	cmdGet.AddCommand(newGetQuotasCmd())
+	cmdGet.AddCommand(newGetDelegationTokensCmd())
 
 	return cmdGet
 }
@@ -0,0 +1,36 @@
+package importing
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroupoffsets"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newImportConsumerGroupOffsetsCmd() *cobra.Command {
+
+	var flags consumergroupoffsets.ImportConsumerGroupOffsetsFlags
+
+	var cmdImportOffsets = &cobra.Command{
+		Use:     "consumer-group-offsets",
+		Aliases: []string{"cgo", "offsets"},
+		Short:   "restore committed offsets of consumer groups from a snapshot file",
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if internal.IsKubernetesEnabled() {
+				return errors.New("import is not supported when running in kubernetes")
+			}
+			return (&consumergroupoffsets.ConsumerGroupOffsetOperation{}).ImportConsumerGroupOffsets(flags)
+		},
+	}
+
+	cmdImportOffsets.Flags().StringVarP(&flags.File, "file", "f", "", "snapshot file created with export consumer-group-offsets")
+	cmdImportOffsets.Flags().StringArrayVarP(&flags.Groups, "group", "g", flags.Groups, "only import the given consumer groups of the snapshot")
+	cmdImportOffsets.Flags().BoolVarP(&flags.TranslateByTimestamp, "translate-by-timestamp", "", false, "translate offsets using the record timestamps of the snapshot, e.g. when a topic was recreated")
+
+	if err := cmdImportOffsets.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+
+	return cmdImportOffsets
+}
@@ -0,0 +1,60 @@
+package importing_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestImportConsumerGroupOffsetsTranslatedByTimestampIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "import-cgo")
+
+	group := testutil.CreateConsumerGroup(t, "import-cgo", topicName)
+
+	testutil.ProduceMessage(t, topicName, "test-key", "a", 0, 0)
+	testutil.ProduceMessage(t, topicName, "test-key", "b", 0, 1)
+
+	time.Sleep(10 * time.Millisecond)
+	timestamp := time.Now().UTC()
+	time.Sleep(10 * time.Millisecond)
+
+	testutil.ProduceMessage(t, topicName, "test-key", "c", 0, 2)
+	testutil.ProduceMessage(t, topicName, "test-key", "d", 0, 3)
+
+	// snapshot of a previous incarnation of the topic with offsets beyond the current ones
+	snapshot := fmt.Sprintf(`createdAt: %s
+consumerGroups:
+- name: %s
+  topics:
+  - name: %s
+    partitions:
+    - partition: 0
+      offset: 100
+      timestamp: %s
+`, time.Now().UTC().Format(time.RFC3339Nano), group, topicName, timestamp.Format(time.RFC3339Nano))
+
+	snapshotFile := filepath.Join(t.TempDir(), "snapshot.yaml")
+	if err := os.WriteFile(snapshotFile, []byte(snapshot), 0o600); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("import", "consumer-group-offsets", "-f", snapshotFile)
+	testutil.AssertErrorContains(t, "is out of range", err)
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("import", "consumer-group-offsets", "-f", snapshotFile, "--translate-by-timestamp"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 2)
+}
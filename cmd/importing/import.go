@@ -0,0 +1,15 @@
+package importing
+
+import "github.com/spf13/cobra"
+
+func NewImportCmd() *cobra.Command {
+
+	var cmdImport = &cobra.Command{
+		Use:   "import",
+		Short: "import consumer-group-offsets",
+	}
+
+	cmdImport.AddCommand(newImportConsumerGroupOffsetsCmd())
+
+	return cmdImport
+}
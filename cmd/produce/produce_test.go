@@ -715,6 +715,52 @@ func TestProduceWithJSONFileBase64ValuesIntegration(t *testing.T) {
 	testutil.AssertEquals(t, "1#000000000001\n2#68656c6c6f\n3#6b61666b61", kafkaCtl.GetStdOut())
 }
 
+func TestProduceWithAvroOCFFileIntegration(t *testing.T) {
+	testutil.StartIntegrationTest(t)
+	topic := testutil.CreateTopic(t, "produce-topic-avro-ocf")
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	dataFilePath := filepath.Join(testutil.RootDir, "internal", "testutil", "testdata")
+
+	if _, err := kafkaCtl.Execute("produce", topic, "--key", "my-key",
+		"--file", filepath.Join(dataFilePath, "msg.avro"),
+		"--input-format", "avro-ocf"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "3 messages produced", kafkaCtl.GetStdOut())
+
+	if _, err := kafkaCtl.Execute("consume", topic, "--from-beginning", "--print-keys", "--exit"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	expectedMessages := []string{`my-key#{"id":1,"name":"a"}`, `my-key#{"id":2,"name":"b"}`, `my-key#{"id":3,"name":"c"}`}
+	testutil.AssertArraysEquals(t, expectedMessages, kafkaCtl.GetStdOutLines())
+}
+
+func TestProduceWithParquetFileIntegration(t *testing.T) {
+	testutil.StartIntegrationTest(t)
+	topic := testutil.CreateTopic(t, "produce-topic-parquet")
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	dataFilePath := filepath.Join(testutil.RootDir, "internal", "testutil", "testdata")
+
+	if _, err := kafkaCtl.Execute("produce", topic, "--key", "my-key",
+		"--file", filepath.Join(dataFilePath, "msg.parquet"),
+		"--input-format", "parquet"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "3 messages produced", kafkaCtl.GetStdOut())
+
+	if _, err := kafkaCtl.Execute("consume", topic, "--from-beginning", "--print-keys", "--exit"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	expectedMessages := []string{`my-key#{"id":1,"name":"a"}`, `my-key#{"id":2,"name":"b"}`, `my-key#{"id":3,"name":"c"}`}
+	testutil.AssertArraysEquals(t, expectedMessages, kafkaCtl.GetStdOutLines())
+}
+
 func TestProduceProtoFileWithOnlyKeyEncodedIntegration(t *testing.T) {
 	testutil.StartIntegrationTest(t)
 
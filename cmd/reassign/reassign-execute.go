@@ -0,0 +1,38 @@
+package reassign
+
+import (
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/partition"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newReassignExecuteCmd() *cobra.Command {
+
+	var flags partition.ExecuteReassignmentFlags
+
+	var cmdReassignExecute = &cobra.Command{
+		Use:   "execute",
+		Short: "start the reassignment of a plan",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if internal.IsKubernetesEnabled() {
+				return errors.New("reassign execute is not supported when running in kubernetes")
+			}
+			return (&partition.Operation{}).ExecuteReassignment(flags)
+		},
+	}
+
+	cmdReassignExecute.Flags().StringVarP(&flags.File, "file", "f", "", "reassignment plan created with reassign plan")
+	cmdReassignExecute.Flags().StringVarP(&flags.Throttle, "throttle", "", "", "limit the replication traffic per broker (e.g. 50MB/s)")
+	cmdReassignExecute.Flags().BoolVarP(&flags.Wait, "wait", "w", false, "wait until the reassignment is completed and remove throttles")
+	cmdReassignExecute.Flags().DurationVarP(&flags.Interval, "interval", "", 5*time.Second, "interval to check the progress with --wait")
+
+	if err := cmdReassignExecute.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+
+	return cmdReassignExecute
+}
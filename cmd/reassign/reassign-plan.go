@@ -0,0 +1,46 @@
+package reassign
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/deviceinsight/kafkactl/v5/internal/partition"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newReassignPlanCmd() *cobra.Command {
+
+	var flags partition.PlanReassignmentFlags
+
+	var cmdReassignPlan = &cobra.Command{
+		Use:   "plan",
+		Short: "generate a balanced, rack-aware reassignment plan for topics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				if flags.File != "" {
+					return errors.New("parameter --file is not supported when running in kubernetes")
+				}
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&partition.Operation{}).PlanReassignment(flags)
+		},
+	}
+
+	cmdReassignPlan.Flags().StringSliceVarP(&flags.Topics, "topics", "t", nil, "topics to reassign (glob patterns are supported)")
+	cmdReassignPlan.Flags().Int32SliceVarP(&flags.Brokers, "brokers", "b", nil, "target brokers (default: all brokers)")
+	cmdReassignPlan.Flags().Int32SliceVarP(&flags.Decommission, "decommission", "", nil, "brokers to move all replicas away from")
+	cmdReassignPlan.Flags().StringVarP(&flags.File, "file", "f", "", "write the plan to a file")
+	cmdReassignPlan.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	if err := cmdReassignPlan.MarkFlagRequired("topics"); err != nil {
+		panic(err)
+	}
+
+	if err := cmdReassignPlan.RegisterFlagCompletionFunc("topics", topic.CompleteTopicNames); err != nil {
+		panic(err)
+	}
+
+	return cmdReassignPlan
+}
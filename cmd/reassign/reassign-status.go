@@ -0,0 +1,38 @@
+package reassign
+
+import (
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/partition"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newReassignStatusCmd() *cobra.Command {
+
+	var flags partition.ReassignmentStatusFlags
+
+	var cmdReassignStatus = &cobra.Command{
+		Use:   "status",
+		Short: "show the progress of a reassignment and remove throttles when it is completed",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if internal.IsKubernetesEnabled() {
+				return errors.New("reassign status is not supported when running in kubernetes")
+			}
+			return (&partition.Operation{}).ReassignmentStatus(flags)
+		},
+	}
+
+	cmdReassignStatus.Flags().StringVarP(&flags.File, "file", "f", "", "reassignment plan created with reassign plan")
+	cmdReassignStatus.Flags().BoolVarP(&flags.Wait, "wait", "w", false, "wait until the reassignment is completed")
+	cmdReassignStatus.Flags().DurationVarP(&flags.Interval, "interval", "", 5*time.Second, "interval to check the progress with --wait")
+	cmdReassignStatus.Flags().StringVarP(&flags.OutputFormat, "output", "o", flags.OutputFormat, "output format. One of: json|yaml")
+
+	if err := cmdReassignStatus.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+
+	return cmdReassignStatus
+}
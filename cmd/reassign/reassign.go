@@ -0,0 +1,17 @@
+package reassign
+
+import "github.com/spf13/cobra"
+
+func NewReassignCmd() *cobra.Command {
+
+	var cmdReassign = &cobra.Command{
+		Use:   "reassign",
+		Short: "plan and execute partition reassignments",
+	}
+
+	cmdReassign.AddCommand(newReassignPlanCmd())
+	cmdReassign.AddCommand(newReassignExecuteCmd())
+	cmdReassign.AddCommand(newReassignStatusCmd())
+
+	return cmdReassign
+}
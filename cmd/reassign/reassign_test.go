@@ -0,0 +1,83 @@
+package reassign_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/partition"
+	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
+)
+
+func TestReassignDecommissionBrokerIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "reassign-decommission-", "--partitions", "3", "--replication-factor", "2")
+	planFile := filepath.Join(t.TempDir(), "plan.json")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reassign", "plan", "--topics", topicName, "--decommission", "103", "--file", planFile); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	data, err := os.ReadFile(planFile)
+	if err != nil {
+		t.Fatalf("failed to read plan: %v", err)
+	}
+
+	var plan partition.ReassignmentPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		t.Fatalf("failed to parse plan: %v", err)
+	}
+
+	if len(plan.Partitions) == 0 {
+		t.Fatalf("expected partitions to be moved away from broker 103")
+	}
+
+	for _, p := range plan.Partitions {
+		for _, replica := range p.Replicas {
+			if replica == 103 {
+				t.Fatalf("replica on decommissioned broker in plan: %v", p)
+			}
+		}
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reassign", "execute", "--file", planFile, "--throttle", "50MB/s", "--wait", "--interval", "1s"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, "reassignment completed", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reassign", "plan", "--topics", topicName, "--decommission", "103"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertEquals(t, "no partitions need to be reassigned", kafkaCtl.GetStdOut())
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("describe", "broker", "101"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.AssertContainNoSubstring(t, "replication.throttled.rate", kafkaCtl.GetStdOut())
+}
+
+func TestReassignPlanUnknownBrokerIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "reassign-unknown-broker-")
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("reassign", "plan", "--topics", topicName, "--brokers", "101,999")
+	testutil.AssertErrorContains(t, "unknown broker id: 999", err)
+}
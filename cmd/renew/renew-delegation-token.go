@@ -0,0 +1,34 @@
+package renew
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/delegationtoken"
+	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/spf13/cobra"
+)
+
+func newRenewDelegationTokenCmd() *cobra.Command {
+
+	var flags delegationtoken.RenewDelegationTokenFlags
+
+	var cmdRenewDelegationToken = &cobra.Command{
+		Use:   "delegation-token",
+		Short: "renew a delegation token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if internal.IsKubernetesEnabled() {
+				return k8s.NewOperation().Run(cmd, args)
+			}
+			return (&delegationtoken.Operation{}).RenewDelegationToken(flags)
+		},
+	}
+
+	cmdRenewDelegationToken.Flags().StringVarP(&flags.HMAC, "hmac", "", "", "base64 encoded hmac of the token (may be a credential reference, e.g. env:TOKEN_HMAC)")
+	cmdRenewDelegationToken.Flags().DurationVarP(&flags.RenewPeriod, "renew-period", "", 0, "period to extend the expiry time by (e.g. 24h). The default is the expiry time configured on the broker.")
+
+	if err := cmdRenewDelegationToken.MarkFlagRequired("hmac"); err != nil {
+		panic(err)
+	}
+
+	return cmdRenewDelegationToken
+}
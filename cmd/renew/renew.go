@@ -0,0 +1,15 @@
+package renew
+
+import "github.com/spf13/cobra"
+
+func NewRenewCmd() *cobra.Command {
+
+	var cmdRenew = &cobra.Command{
+		Use:   "renew",
+		Short: "renew delegation tokens",
+	}
+
+	cmdRenew.AddCommand(newRenewDelegationTokenCmd())
+
+	return cmdRenew
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/deviceinsight/kafkactl/v5/internal/consumergroupoffsets"
 	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,9 @@ func newResetOffsetCmd() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if internal.IsKubernetesEnabled() {
+				if offsetFlags.FromFile != "" {
+					return errors.New("parameter --from-file is not supported when running in kubernetes")
+				}
 				return k8s.NewOperation().Run(cmd, args)
 			}
 			return (&consumergroupoffsets.ConsumerGroupOffsetOperation{}).ResetConsumerGroupOffset(offsetFlags, args[0])
@@ -35,6 +39,10 @@ func newResetOffsetCmd() *cobra.Command {
 	cmdResetOffset.Flags().BoolVarP(&offsetFlags.Execute, "execute", "e", false, "execute the reset (as default only the results are displayed for validation)")
 	cmdResetOffset.Flags().StringVarP(&offsetFlags.OutputFormat, "output", "o", offsetFlags.OutputFormat, "output format. One of: json|yaml")
 	cmdResetOffset.Flags().StringVarP(&offsetFlags.ToDatetime, "to-datetime", "", "", "set the offset to offset of given timestamp")
+	cmdResetOffset.Flags().DurationVarP(&offsetFlags.ByDuration, "by-duration", "", 0, "set the offset to offset of the given duration before now (e.g. 2h)")
+	cmdResetOffset.Flags().Int64VarP(&offsetFlags.ShiftBy, "shift-by", "", 0, "shift the current offset by n. Use negative values to shift backwards")
+	cmdResetOffset.Flags().BoolVarP(&offsetFlags.ToCurrent, "to-current", "", false, "set the offset to the current offset")
+	cmdResetOffset.Flags().StringVarP(&offsetFlags.FromFile, "from-file", "", "", "set the offsets given in a csv (topic,partition,offset) or json file (output of a reset with -o json)")
 
 	return cmdResetOffset
 }
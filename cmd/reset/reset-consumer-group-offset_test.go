@@ -2,6 +2,8 @@ package reset_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -268,3 +270,157 @@ func TestResetCGOAutoCompletionIntegration(t *testing.T) {
 	testutil.AssertContains(t, group2, outputLines)
 	testutil.AssertContains(t, group3, outputLines)
 }
+
+func TestResetCGOShiftByIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "reset-cgo-shift")
+
+	group := testutil.CreateConsumerGroup(t, "reset-cgo-shift", topicName)
+
+	for i := 0; i < 5; i++ {
+		testutil.ProduceMessage(t, topicName, "test-key", "value", 0, int64(i))
+	}
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--shift-by", "3", "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 3)
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--shift-by=-2", "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 1)
+
+	// shifting beyond the newest offset stops at the newest offset
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--shift-by", "100", "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 5)
+}
+
+func TestResetCGOToCurrentAndByDurationIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "reset-cgo-duration")
+
+	group := testutil.CreateConsumerGroup(t, "reset-cgo-duration", topicName)
+
+	testutil.ProduceMessage(t, topicName, "test-key", "a", 0, 0)
+	producedA := time.Now()
+
+	// the gap between the messages makes the offsets for the durations below independent of execution time
+	time.Sleep(2 * time.Second)
+
+	producedB := time.Now()
+	testutil.ProduceMessage(t, topicName, "test-key", "b", 0, 1)
+	testutil.ProduceMessage(t, topicName, "test-key", "c", 0, 2)
+	producedC := time.Now()
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--to-current", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	results := parseResetPartitionOffsets(t, kafkaCtl.GetStdOut())
+	testutil.AssertIntEquals(t, 1, len(results))
+	testutil.AssertIntEquals(t, 0, int(results[0].TargetOffset))
+
+	byDuration := func(duration time.Duration) int64 {
+		kafkaCtl := testutil.CreateKafkaCtlCommand()
+		if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--by-duration", duration.String(), "-o", "json"); err != nil {
+			t.Fatalf("failed to execute command: %v", err)
+		}
+		results := parseResetPartitionOffsets(t, kafkaCtl.GetStdOut())
+		testutil.AssertIntEquals(t, 1, len(results))
+		return results[0].TargetOffset
+	}
+
+	// a duration before all messages resets to the first message
+	testutil.AssertIntEquals(t, 0, int(byDuration(time.Since(producedA)+time.Hour)))
+
+	// a duration between the messages resets to the first message after it
+	testutil.AssertIntEquals(t, 1, int(byDuration(time.Since(producedB)+time.Second)))
+
+	// a duration after all messages resets to the newest offset
+	testutil.AssertIntEquals(t, 3, int(byDuration(time.Since(producedC))))
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--by-duration", (time.Since(producedB) + time.Second).String(), "--execute"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	testutil.VerifyConsumerGroupOffset(t, group, topicName, 1)
+}
+
+func TestResetCGOFromFileIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	topicName := testutil.CreateTopic(t, "reset-cgo-file", "--partitions", "2")
+
+	group := testutil.CreateConsumerGroup(t, "reset-cgo-file", topicName)
+
+	testutil.ProduceMessageOnPartition(t, topicName, "test-key", "a", 0, 0)
+	testutil.ProduceMessageOnPartition(t, topicName, "test-key", "b", 0, 1)
+	testutil.ProduceMessageOnPartition(t, topicName, "test-key", "c", 1, 0)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	// the output of a dry run can be used as input file
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--topic", topicName, "--newest", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	offsetsFile := filepath.Join(t.TempDir(), "offsets.json")
+	if err := os.WriteFile(offsetsFile, []byte(kafkaCtl.GetStdOut()), 0o600); err != nil {
+		t.Fatalf("failed to write offsets file: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--from-file", offsetsFile, "--execute", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	results := parseResetPartitionOffsets(t, kafkaCtl.GetStdOut())
+	testutil.AssertIntEquals(t, 2, len(results))
+	testutil.AssertIntEquals(t, 2, int(results[0].TargetOffset))
+	testutil.AssertIntEquals(t, 1, int(results[1].TargetOffset))
+
+	csvFile := filepath.Join(t.TempDir(), "offsets.csv")
+	if err := os.WriteFile(csvFile, []byte(topicName+",0,1\n"), 0o600); err != nil {
+		t.Fatalf("failed to write offsets file: %v", err)
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", group, "--from-file", csvFile, "--execute", "-o", "json"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	results = parseResetPartitionOffsets(t, kafkaCtl.GetStdOut())
+	testutil.AssertIntEquals(t, 1, len(results))
+	testutil.AssertIntEquals(t, 0, int(results[0].Partition))
+	testutil.AssertIntEquals(t, 2, int(results[0].CurrentOffset))
+	testutil.AssertIntEquals(t, 1, int(results[0].TargetOffset))
+}
+
+func TestResetCGOWithExclusiveStrategiesIntegration(t *testing.T) {
+
+	testutil.StartIntegrationTest(t)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	_, err := kafkaCtl.Execute("reset", "offset", "any-group", "--topic", "any-topic", "--oldest", "--shift-by", "1")
+	testutil.AssertErrorContains(t, "are exclusive", err)
+}
@@ -5,20 +5,30 @@ import (
 	"os"
 
 	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/audit"
 	"github.com/deviceinsight/kafkactl/v5/internal/global"
 	"github.com/hashicorp/go-plugin"
 
 	"github.com/deviceinsight/kafkactl/v5/cmd/alter"
 	"github.com/deviceinsight/kafkactl/v5/cmd/attach"
+	"github.com/deviceinsight/kafkactl/v5/cmd/check"
 	"github.com/deviceinsight/kafkactl/v5/cmd/clone"
 	"github.com/deviceinsight/kafkactl/v5/cmd/config"
 	"github.com/deviceinsight/kafkactl/v5/cmd/consume"
 	"github.com/deviceinsight/kafkactl/v5/cmd/create"
 	"github.com/deviceinsight/kafkactl/v5/cmd/deletion"
 	"github.com/deviceinsight/kafkactl/v5/cmd/describe"
+	"github.com/deviceinsight/kafkactl/v5/cmd/elect"
+	"github.com/deviceinsight/kafkactl/v5/cmd/expire"
+	"github.com/deviceinsight/kafkactl/v5/cmd/export"
+	"github.com/deviceinsight/kafkactl/v5/cmd/find"
 	"github.com/deviceinsight/kafkactl/v5/cmd/get"
+	"github.com/deviceinsight/kafkactl/v5/cmd/importing"
 	"github.com/deviceinsight/kafkactl/v5/cmd/produce"
+	"github.com/deviceinsight/kafkactl/v5/cmd/reassign"
+	"github.com/deviceinsight/kafkactl/v5/cmd/renew"
 	"github.com/deviceinsight/kafkactl/v5/cmd/reset"
+	"github.com/deviceinsight/kafkactl/v5/cmd/ui"
 	"github.com/deviceinsight/kafkactl/v5/internal/k8s"
 	"github.com/deviceinsight/kafkactl/v5/internal/output"
 	"github.com/spf13/cobra"
@@ -44,7 +54,18 @@ func NewKafkactlCommand(streams output.IOStreams) *cobra.Command {
 	})
 	cobra.OnFinalize(plugin.CleanupClients)
 
-	rootCmd.PersistentPostRunE = func(_ *cobra.Command, _ []string) error {
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if audit.Enabled() && isAudited(cmd) {
+			auditFailures(cmd)
+		}
+		return nil
+	}
+
+	// the post run hook is only executed if the command succeeded
+	rootCmd.PersistentPostRunE = func(cmd *cobra.Command, args []string) error {
+		if audit.Enabled() && isAudited(cmd) {
+			writeAuditRecord(cmd, args, nil)
+		}
 		return internal.FlushCredentials()
 	}
 
@@ -54,11 +75,20 @@ func NewKafkactlCommand(streams output.IOStreams) *cobra.Command {
 	rootCmd.AddCommand(alter.NewAlterCmd())
 	rootCmd.AddCommand(deletion.NewDeleteCmd())
 	rootCmd.AddCommand(describe.NewDescribeCmd())
+	rootCmd.AddCommand(find.NewFindCmd())
 	rootCmd.AddCommand(get.NewGetCmd())
 	rootCmd.AddCommand(produce.NewProduceCmd())
 	rootCmd.AddCommand(reset.NewResetCmd())
+	rootCmd.AddCommand(reassign.NewReassignCmd())
+	rootCmd.AddCommand(elect.NewElectCmd())
+	rootCmd.AddCommand(renew.NewRenewCmd())
+	rootCmd.AddCommand(expire.NewExpireCmd())
+	rootCmd.AddCommand(check.NewCheckCmd())
 	rootCmd.AddCommand(attach.NewAttachCmd())
 	rootCmd.AddCommand(clone.NewCloneCmd())
+	rootCmd.AddCommand(export.NewExportCmd())
+	rootCmd.AddCommand(importing.NewImportCmd())
+	rootCmd.AddCommand(ui.NewUICmd())
 	rootCmd.AddCommand(newCompletionCmd())
 	rootCmd.AddCommand(newVersionCmd())
 	rootCmd.AddCommand(newDocsCmd())
@@ -71,6 +101,7 @@ func NewKafkactlCommand(streams output.IOStreams) *cobra.Command {
 	rootCmd.PersistentFlags().BoolVarP(&globalFlags.Verbose, "verbose", "V", false, "verbose output")
 	rootCmd.PersistentFlags().StringVar(&globalFlags.Context, "context", "", "The name of the context to use")
 	rootCmd.PersistentFlags().BoolVar(&globalFlags.ClearKeyring, "clear-keyring", false, "clear stored credentials from keyring and re-prompt")
+	rootCmd.PersistentFlags().BoolVarP(&globalFlags.SkipConfirmation, "yes", "y", false, "do not ask for confirmations, e.g. before deleting topics")
 
 	err := rootCmd.RegisterFlagCompletionFunc("context", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return global.ListAvailableContexts(), cobra.ShellCompDirectiveNoFileComp
@@ -86,3 +117,68 @@ func NewKafkactlCommand(streams output.IOStreams) *cobra.Command {
 	rootCmd.SetErr(streams.ErrOut)
 	return rootCmd
 }
+
+// auditedCommands are the commands that modify the cluster and are written to the audit log
+var auditedCommands = map[string]bool{
+	"alter":    true,
+	"clone":    true,
+	"create":   true,
+	"delete":   true,
+	"elect":    true,
+	"expire":   true,
+	"import":   true,
+	"produce":  true,
+	"reassign": true,
+	"renew":    true,
+	"reset":    true,
+}
+
+// readOnlyCommands are sub commands of audited commands that do not modify the cluster
+var readOnlyCommands = map[string]bool{
+	"kafkactl reassign plan":   true,
+	"kafkactl reassign status": true,
+}
+
+// isAudited returns true if the command modifies the cluster. Commands with an --execute flag, like
+// reset offset, only modify the cluster if the flag is set.
+func isAudited(cmd *cobra.Command) bool {
+	command := cmd
+	for command.HasParent() && command.Parent().HasParent() {
+		command = command.Parent()
+	}
+	if !auditedCommands[command.Name()] || readOnlyCommands[cmd.CommandPath()] {
+		return false
+	}
+	if execute, err := cmd.Flags().GetBool("execute"); err == nil && !execute {
+		return false
+	}
+	return true
+}
+
+// auditFailures writes an audit record if the pre run or run function of the command fails.
+func auditFailures(cmd *cobra.Command) {
+	if preRun := cmd.PreRunE; preRun != nil {
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			err := preRun(cmd, args)
+			if err != nil {
+				writeAuditRecord(cmd, args, err)
+			}
+			return err
+		}
+	}
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			err := run(cmd, args)
+			if err != nil {
+				writeAuditRecord(cmd, args, err)
+			}
+			return err
+		}
+	}
+}
+
+func writeAuditRecord(cmd *cobra.Command, args []string, err error) {
+	if auditErr := audit.Log(audit.NewRecord(cmd, args, err)); auditErr != nil {
+		output.Warnf("failed to write audit log: %v", auditErr)
+	}
+}
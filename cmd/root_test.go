@@ -1,11 +1,14 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/deviceinsight/kafkactl/v5/internal/audit"
 	"github.com/deviceinsight/kafkactl/v5/internal/global"
 
 	"github.com/deviceinsight/kafkactl/v5/internal/testutil"
@@ -58,6 +61,18 @@ func TestEnvironmentVariableLoadingAliases(t *testing.T) {
 	_ = os.Setenv(global.SaslMechanism, "oauth")
 	_ = os.Setenv(global.SaslTokenProviderPlugin, "azure")
 	_ = os.Setenv(global.SaslTokenProviderOptions, `{"tenantid": "azure-tenant-id", "int-key": 12}`)
+	_ = os.Setenv(global.SaslTokenAuth, "true")
+	_ = os.Setenv(global.SaslAWSRegion, "eu-central-1")
+	_ = os.Setenv(global.SaslAWSProfile, "msk")
+	_ = os.Setenv(global.SaslAWSRoleArn, "arn:aws:iam::123456789012:role/kafka")
+	_ = os.Setenv(global.SaslAWSRoleSessionName, "session")
+	_ = os.Setenv(global.SaslKerberosAuthType, "keytab")
+	_ = os.Setenv(global.SaslKerberosKeytabPath, "kafka.keytab")
+	_ = os.Setenv(global.SaslKerberosCCachePath, "/tmp/krb5cc_1000")
+	_ = os.Setenv(global.SaslKerberosConfigPath, "krb5.conf")
+	_ = os.Setenv(global.SaslKerberosRealm, "EXAMPLE.COM")
+	_ = os.Setenv(global.SaslKerberosServiceName, "kafka")
+	_ = os.Setenv(global.SaslKerberosDisablePAFXFAST, "true")
 	_ = os.Setenv(global.ClientID, "my-client")
 	_ = os.Setenv(global.KafkaVersion, "2.0.1")
 	_ = os.Setenv(global.AvroJSONCodec, "avro")
@@ -77,6 +92,9 @@ func TestEnvironmentVariableLoadingAliases(t *testing.T) {
 	_ = os.Setenv(global.ProducerPartitioner, "hash")
 	_ = os.Setenv(global.ProducerRequiredAcks, "WaitForAll")
 	_ = os.Setenv(global.ProducerMaxMessageBytes, "1234")
+	_ = os.Setenv(global.ProtectionReadOnly, "true")
+	_ = os.Setenv(global.ProtectionProtectedTopics, "orders payments-*")
+	_ = os.Setenv(global.ProtectionRequireConfirmation, "true")
 
 	for _, key := range global.EnvVariables {
 		if os.Getenv(key) == "" {
@@ -110,6 +128,18 @@ func TestEnvironmentVariableLoadingAliases(t *testing.T) {
 	testutil.AssertEquals(t, "azure", viper.GetString("contexts.default.sasl.tokenProvider.plugin"))
 	testutil.AssertEquals(t, "azure-tenant-id", viper.GetStringMap("contexts.default.sasl.tokenProvider.options")["tenantid"].(string))
 	testutil.AssertEquals(t, "12", fmt.Sprint(viper.GetStringMap("contexts.default.sasl.tokenProvider.options")["int-key"].(float64)))
+	testutil.AssertEquals(t, "true", viper.GetString("contexts.default.sasl.tokenAuth"))
+	testutil.AssertEquals(t, "eu-central-1", viper.GetString("contexts.default.sasl.aws.region"))
+	testutil.AssertEquals(t, "msk", viper.GetString("contexts.default.sasl.aws.profile"))
+	testutil.AssertEquals(t, "arn:aws:iam::123456789012:role/kafka", viper.GetString("contexts.default.sasl.aws.roleArn"))
+	testutil.AssertEquals(t, "session", viper.GetString("contexts.default.sasl.aws.roleSessionName"))
+	testutil.AssertEquals(t, "keytab", viper.GetString("contexts.default.sasl.kerberos.authType"))
+	testutil.AssertEquals(t, "kafka.keytab", viper.GetString("contexts.default.sasl.kerberos.keytabPath"))
+	testutil.AssertEquals(t, "/tmp/krb5cc_1000", viper.GetString("contexts.default.sasl.kerberos.ccachePath"))
+	testutil.AssertEquals(t, "krb5.conf", viper.GetString("contexts.default.sasl.kerberos.configPath"))
+	testutil.AssertEquals(t, "EXAMPLE.COM", viper.GetString("contexts.default.sasl.kerberos.realm"))
+	testutil.AssertEquals(t, "kafka", viper.GetString("contexts.default.sasl.kerberos.serviceName"))
+	testutil.AssertEquals(t, "true", viper.GetString("contexts.default.sasl.kerberos.disablePAFXFAST"))
 	testutil.AssertEquals(t, "my-client", viper.GetString("contexts.default.clientID"))
 	testutil.AssertEquals(t, "2.0.1", viper.GetString("contexts.default.kafkaVersion"))
 	testutil.AssertEquals(t, "avro", viper.GetString("contexts.default.avro.jsonCodec"))
@@ -129,6 +159,9 @@ func TestEnvironmentVariableLoadingAliases(t *testing.T) {
 	testutil.AssertEquals(t, "hash", viper.GetString("contexts.default.producer.partitioner"))
 	testutil.AssertEquals(t, "WaitForAll", viper.GetString("contexts.default.producer.requiredAcks"))
 	testutil.AssertEquals(t, "1234", viper.GetString("contexts.default.producer.maxMessageBytes"))
+	testutil.AssertEquals(t, "true", viper.GetString("contexts.default.protection.readOnly"))
+	testutil.AssertEquals(t, "payments-*", viper.GetStringSlice("contexts.default.protection.protectedTopics")[1])
+	testutil.AssertEquals(t, "true", viper.GetString("contexts.default.protection.requireConfirmation"))
 }
 
 func TestContextFlag(t *testing.T) {
@@ -173,5 +206,69 @@ func TestContextFlagAutoCompletion(t *testing.T) {
 
 	outputLines := strings.Split(strings.TrimSpace(kafkaCtl.GetStdOut()), "\n")
 
-	testutil.AssertArraysEquals(t, []string{"default", "k8s-mock", "no-schema-reg", "sasl-admin", "sasl-user", "scram-admin"}, outputLines[:len(outputLines)-1])
+	testutil.AssertArraysEquals(t, []string{"default", "k8s-mock", "no-schema-reg", "protected", "read-only", "sasl-admin", "sasl-user", "scram-admin"}, outputLines[:len(outputLines)-1])
+}
+
+func TestAuditLogOfModifyingCommand(t *testing.T) {
+
+	testutil.StartUnitTest(t)
+	testutil.SwitchContext("read-only")
+
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+	t.Setenv("AUDIT_FILE", auditFile)
+
+	kafkaCtl := testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("produce", "my-topic", "--value", "my-value"); err == nil {
+		t.Fatalf("expected produce in read-only context to fail")
+	}
+
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("get", "topics", "--help"); err != nil {
+		t.Fatalf("failed to execute command: %v", err)
+	}
+
+	// failures of the pre run function are audited
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("create", "acl"); err == nil {
+		t.Fatalf("expected create acl without flags to fail")
+	}
+
+	// a reset without --execute does not modify the cluster
+	kafkaCtl = testutil.CreateKafkaCtlCommand()
+
+	if _, err := kafkaCtl.Execute("reset", "offset", "my-group", "--topic", "my-topic", "--oldest", "--shift-by", "1"); err == nil {
+		t.Fatalf("expected reset with exclusive strategies to fail")
+	}
+
+	content, err := os.ReadFile(auditFile)
+	if err != nil {
+		t.Fatalf("unable to read audit log: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two audit records, got: %s", content)
+	}
+
+	var record audit.Record
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("unable to parse audit record: %v", err)
+	}
+
+	testutil.AssertEquals(t, "kafkactl produce", record.Command)
+	testutil.AssertEquals(t, "read-only", record.Context)
+	testutil.AssertEquals(t, audit.ResultFailure, record.Result)
+	testutil.AssertContainSubstring(t, "context 'read-only' is read-only", record.Error)
+	testutil.AssertContains(t, "--value=<8 bytes>", record.Args)
+	testutil.AssertIntEquals(t, 3, len(record.Brokers))
+
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatalf("unable to parse audit record: %v", err)
+	}
+
+	testutil.AssertEquals(t, "kafkactl create access-control-list", record.Command)
+	testutil.AssertEquals(t, audit.ResultFailure, record.Result)
 }
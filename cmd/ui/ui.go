@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/ui"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func NewUICmd() *cobra.Command {
+	var flags ui.Flags
+
+	var cmdUI = &cobra.Command{
+		Use:   "ui",
+		Short: "interactive terminal ui to browse topics, messages and consumer groups",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if internal.IsKubernetesEnabled() {
+				return errors.New("ui is not supported when running in kubernetes. use `kafkactl attach` and run the ui in the pod")
+			}
+			return (&ui.Operation{}).Run(flags)
+		},
+	}
+
+	cmdUI.Flags().IntVarP(&flags.PageSize, "page-size", "", 50, "number of messages per page")
+
+	return cmdUI
+}
@@ -0,0 +1,132 @@
+package acl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// readACLFile reads acls in the format of `get acl -o yaml` or `get acl -o json`
+func readACLFile(path string) ([]*sarama.ResourceAcls, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read acl file")
+	}
+
+	var entries []ResourceACLEntry
+
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = json.Unmarshal(content, &entries)
+	} else {
+		err = yaml.Unmarshal(content, &entries)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse acl file %s", path)
+	}
+
+	resourceAcls, err := toResourceAcls(entries)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid acl file %s", path)
+	}
+	return resourceAcls, nil
+}
+
+func toResourceAcls(entries []ResourceACLEntry) ([]*sarama.ResourceAcls, error) {
+
+	resourceAcls := make([]*sarama.ResourceAcls, 0, len(entries))
+
+	for _, entry := range entries {
+		resource := sarama.Resource{
+			ResourceType:        resourceTypeFromString(entry.ResourceType),
+			ResourceName:        entry.ResourceName,
+			ResourcePatternType: patternTypeFromString(entry.PatternType),
+		}
+
+		switch resource.ResourceType {
+		case sarama.AclResourceUnknown, sarama.AclResourceAny:
+			return nil, errors.Errorf("invalid resource type: %s", entry.ResourceType)
+		}
+
+		switch resource.ResourcePatternType {
+		case sarama.AclPatternLiteral, sarama.AclPatternPrefixed:
+		default:
+			return nil, errors.Errorf("invalid pattern type of %s %s: %s", entry.ResourceType, entry.ResourceName, entry.PatternType)
+		}
+
+		acls := &sarama.ResourceAcls{Resource: resource}
+
+		for _, aclEntry := range entry.Acls {
+			acl := &sarama.Acl{
+				Principal:      aclEntry.Principal,
+				Host:           aclEntry.Host,
+				Operation:      operationFromString(aclEntry.Operation),
+				PermissionType: permissionTypeFromString(aclEntry.PermissionType),
+			}
+
+			if acl.Principal == "" || acl.Host == "" {
+				return nil, errors.Errorf("principal and host of acls for %s %s must be set", entry.ResourceType, entry.ResourceName)
+			}
+
+			switch acl.Operation {
+			case sarama.AclOperationUnknown, sarama.AclOperationAny:
+				return nil, errors.Errorf("invalid operation of %s %s: %s", entry.ResourceType, entry.ResourceName, aclEntry.Operation)
+			}
+
+			switch acl.PermissionType {
+			case sarama.AclPermissionAllow, sarama.AclPermissionDeny:
+			default:
+				return nil, errors.Errorf("invalid permission type of %s %s: %s", entry.ResourceType, entry.ResourceName, aclEntry.PermissionType)
+			}
+
+			acls.Acls = append(acls.Acls, acl)
+		}
+
+		resourceAcls = append(resourceAcls, acls)
+	}
+
+	return resourceAcls, nil
+}
+
+// withoutExistingACLs removes acls that already exist in the cluster, so that a file can be applied multiple times
+func withoutExistingACLs(resourceAcls []*sarama.ResourceAcls, existing []sarama.ResourceAcls) []*sarama.ResourceAcls {
+
+	existingKeys := make(map[string]bool)
+	for _, resource := range existing {
+		for _, acl := range resource.Acls {
+			existingKeys[aclKey(resource.Resource, acl)] = true
+		}
+	}
+
+	missing := make([]*sarama.ResourceAcls, 0, len(resourceAcls))
+
+	for _, resource := range resourceAcls {
+		acls := &sarama.ResourceAcls{Resource: resource.Resource}
+		for _, acl := range resource.Acls {
+			if existingKeys[aclKey(resource.Resource, acl)] {
+				output.Debugf("acl already exists: %s %s %s %s", resourceTypeToString(resource.ResourceType),
+					resource.ResourceName, acl.Principal, operationToString(acl.Operation))
+				continue
+			}
+			acls.Acls = append(acls.Acls, acl)
+		}
+		if len(acls.Acls) > 0 {
+			missing = append(missing, acls)
+		}
+	}
+
+	return missing
+}
+
+func aclKey(resource sarama.Resource, acl *sarama.Acl) string {
+	return fmt.Sprintf("%d|%s|%d|%s|%s|%d|%d", resource.ResourceType, resource.ResourceName, resource.ResourcePatternType,
+		acl.Principal, acl.Host, acl.Operation, acl.PermissionType)
+}
@@ -0,0 +1,123 @@
+package acl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+)
+
+func TestReadACLFile(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "acls.yaml")
+
+	content := `- resourceType: Topic
+  resourceName: orders
+  patternType: Literal
+  acls:
+  - principal: User:app
+    host: '*'
+    operation: Read
+    permissionType: Allow
+- resourceType: Group
+  resourceName: app-
+  patternType: Prefixed
+  acls:
+  - principal: User:app
+    host: '*'
+    operation: Read
+    permissionType: Deny
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	resourceAcls, err := readACLFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resourceAcls) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resourceAcls))
+	}
+	if resourceAcls[1].ResourceType != sarama.AclResourceGroup || resourceAcls[1].ResourcePatternType != sarama.AclPatternPrefixed {
+		t.Fatalf("unexpected resource: %+v", resourceAcls[1].Resource)
+	}
+	if acl := resourceAcls[1].Acls[0]; acl.Operation != sarama.AclOperationRead || acl.PermissionType != sarama.AclPermissionDeny {
+		t.Fatalf("unexpected acl: %+v", acl)
+	}
+}
+
+func TestReadACLFileFailsOnInvalidEntries(t *testing.T) {
+
+	for name, entry := range map[string]ResourceACLEntry{
+		"resource type": {ResourceType: "Any", ResourceName: "orders", PatternType: "Literal"},
+		"pattern type":  {ResourceType: "Topic", ResourceName: "orders", PatternType: "Match"},
+		"operation": {ResourceType: "Topic", ResourceName: "orders", PatternType: "Literal",
+			Acls: []Entry{{Principal: "User:app", Host: "*", Operation: "Any", PermissionType: "Allow"}}},
+		"permission type": {ResourceType: "Topic", ResourceName: "orders", PatternType: "Literal",
+			Acls: []Entry{{Principal: "User:app", Host: "*", Operation: "Read", PermissionType: "Any"}}},
+		"principal": {ResourceType: "Topic", ResourceName: "orders", PatternType: "Literal",
+			Acls: []Entry{{Host: "*", Operation: "Read", PermissionType: "Allow"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := toResourceAcls([]ResourceACLEntry{entry}); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestWithoutExistingACLs(t *testing.T) {
+
+	resource := sarama.Resource{ResourceType: sarama.AclResourceTopic, ResourceName: "orders", ResourcePatternType: sarama.AclPatternLiteral}
+	read := &sarama.Acl{Principal: "User:app", Host: "*", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow}
+	write := &sarama.Acl{Principal: "User:app", Host: "*", Operation: sarama.AclOperationWrite, PermissionType: sarama.AclPermissionAllow}
+
+	existing := []sarama.ResourceAcls{{Resource: resource, Acls: []*sarama.Acl{read}}}
+
+	missing := withoutExistingACLs([]*sarama.ResourceAcls{{Resource: resource, Acls: []*sarama.Acl{read, write}}}, existing)
+	if len(missing) != 1 || len(missing[0].Acls) != 1 || missing[0].Acls[0] != write {
+		t.Fatalf("expected only the write acl to be missing: %+v", missing)
+	}
+
+	missing = withoutExistingACLs([]*sarama.ResourceAcls{{Resource: resource, Acls: []*sarama.Acl{read}}}, existing)
+	if len(missing) != 0 {
+		t.Fatalf("expected no missing acls: %+v", missing)
+	}
+}
+
+func TestDeleteACLsFromFileValidateOnly(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "acls.yaml")
+
+	content := `- resourceType: Topic
+  resourceName: orders
+  patternType: Literal
+  acls:
+  - principal: User:app
+    host: '*'
+    operation: Read
+    permissionType: Allow
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resourceAcls, err := readACLFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output.NewTestIOStreams(nil)
+	stub := &deleteACLAdminStub{acls: []sarama.ResourceAcls{*resourceAcls[0]}}
+
+	if err = deleteACLsFromFile(stub, DeleteACLFlags{File: path, ValidateOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+	if stub.deleted != 0 {
+		t.Fatalf("expected no deletion with validateOnly, got %d", stub.deleted)
+	}
+}
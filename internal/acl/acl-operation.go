@@ -39,29 +39,39 @@ type GetACLFlags struct {
 }
 
 type CreateACLFlags struct {
-	Principal    string
-	Hosts        []string
-	Operations   []string
-	Allow        bool
-	Deny         bool
-	Topic        string
-	Group        string
-	Cluster      bool
-	PatternType  string
-	ValidateOnly bool
+	Principal       string
+	Hosts           []string
+	Operations      []string
+	Allow           bool
+	Deny            bool
+	Topic           string
+	Group           string
+	Cluster         bool
+	PatternType     string
+	ValidateOnly    bool
+	Role            string
+	TransactionalID string
+	Idempotent      bool
+	File            string
 }
 
 type DeleteACLFlags struct {
-	ValidateOnly bool
-	Topics       bool
-	Groups       bool
-	Cluster      bool
-	Allow        bool
-	Deny         bool
-	Principal    string
-	Host         string
-	Operation    string
-	PatternType  string
+	ValidateOnly    bool
+	Topics          bool
+	Groups          bool
+	Cluster         bool
+	Allow           bool
+	Deny            bool
+	Principal       string
+	Host            string
+	Operation       string
+	PatternType     string
+	Role            string
+	Topic           string
+	Group           string
+	TransactionalID string
+	Idempotent      bool
+	File            string
 }
 
 type Operation struct {
@@ -180,6 +190,10 @@ func (operation *Operation) CreateACL(flags CreateACLFlags) error {
 		return errors.Wrap(err, "failed to create cluster admin")
 	}
 
+	if flags.File != "" {
+		return createACLsFromFile(admin, flags)
+	}
+
 	if flags.Principal == "" {
 		return errors.New("principal must be set")
 	}
@@ -189,32 +203,129 @@ func (operation *Operation) CreateACL(flags CreateACLFlags) error {
 		flags.Hosts = append(flags.Hosts, "*")
 	}
 
+	var resourceAcls []*sarama.ResourceAcls
+
+	if flags.Role != "" {
+		if len(flags.Operations) > 0 || flags.Deny {
+			return errors.New("--operation and --deny cannot be used together with --role")
+		}
+		if resourceAcls, err = roleACLs(flags); err != nil {
+			return err
+		}
+	} else if resourceAcls, err = resourceACLsFromFlags(flags); err != nil {
+		return err
+	}
+
+	aclList, err := createACLs(admin, resourceAcls, flags.ValidateOnly)
+	if err != nil {
+		return err
+	}
+
+	return printResourceAcls("", aclList...)
+}
+
+func createACLsFromFile(admin sarama.ClusterAdmin, flags CreateACLFlags) error {
+
+	if flags.Principal != "" || len(flags.Hosts) > 0 || len(flags.Operations) > 0 || flags.Role != "" ||
+		flags.Topic != "" || flags.Group != "" || flags.Cluster || flags.TransactionalID != "" {
+		return errors.New("--file cannot be used together with other acl flags")
+	}
+
+	resourceAcls, err := readACLFile(flags.File)
+	if err != nil {
+		return err
+	}
+
+	existing, err := admin.ListAcls(sarama.AclFilter{
+		ResourceType:              sarama.AclResourceAny,
+		ResourcePatternTypeFilter: sarama.AclPatternAny,
+		PermissionType:            sarama.AclPermissionAny,
+		Operation:                 sarama.AclOperationAny,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list acls")
+	}
+
+	missing := withoutExistingACLs(resourceAcls, existing)
+	if len(missing) == 0 {
+		output.Infof("all acls already exist")
+		return nil
+	}
+
+	aclList, err := createACLs(admin, missing, flags.ValidateOnly)
+	if err != nil {
+		return err
+	}
+
+	return printResourceAcls("", aclList...)
+}
+
+func createACLs(admin sarama.ClusterAdmin, resourceAcls []*sarama.ResourceAcls, validateOnly bool) ([]ResourceACLEntry, error) {
+
+	aclList := make([]ResourceACLEntry, 0)
+
+	for _, resourceAcl := range resourceAcls {
+
+		resourceACL := ResourceACLEntry{
+			ResourceType: resourceTypeToString(resourceAcl.ResourceType),
+			ResourceName: resourceAcl.ResourceName,
+			PatternType:  patternTypeToString(resourceAcl.ResourcePatternType),
+			Acls:         make([]Entry, 0),
+		}
+
+		for _, acl := range resourceAcl.Acls {
+
+			if !validateOnly {
+				if err := admin.CreateACLs([]*sarama.ResourceAcls{{Resource: resourceAcl.Resource, Acls: []*sarama.Acl{acl}}}); err != nil {
+					return nil, errors.Wrap(err, "failed to create acl")
+				}
+			}
+
+			resourceACL.Acls = append(resourceACL.Acls, Entry{
+				Principal:      acl.Principal,
+				Host:           acl.Host,
+				Operation:      operationToString(acl.Operation),
+				PermissionType: permissionTypeToString(acl.PermissionType),
+			})
+		}
+		aclList = append(aclList, resourceACL)
+	}
+
+	return aclList, nil
+}
+
+func resourceACLsFromFlags(flags CreateACLFlags) ([]*sarama.ResourceAcls, error) {
+
 	if len(flags.Operations) == 0 {
-		return errors.New("at least one operation has to be specified")
+		return nil, errors.New("at least one operation has to be specified")
 	}
 
 	if !xor(flags.Allow, flags.Deny) {
-		return errors.New("either --allow or --deny has to be provided")
+		return nil, errors.New("either --allow or --deny has to be provided")
+	}
+
+	if !xor(flags.Topic != "", flags.Group != "", flags.Cluster, flags.TransactionalID != "") {
+		return nil, errors.New("either --topic=topic-name or --group=group-name or --transactional-id=id or --cluster has to be provided")
 	}
 
-	if !xor(flags.Topic != "", flags.Group != "", flags.Cluster) {
-		return errors.New("either --topic=topic-name or --group=group-name or --cluster has to be provided")
+	if flags.Idempotent {
+		return nil, errors.New("--idempotent can only be used together with --role")
 	}
 
-	resource := sarama.Resource{}
+	resource := sarama.Resource{ResourcePatternType: patternTypeFromString(flags.PatternType)}
 
 	if flags.Topic != "" {
 		resource.ResourceType = sarama.AclResourceTopic
 		resource.ResourceName = flags.Topic
-		resource.ResourcePatternType = patternTypeFromString(flags.PatternType)
 	} else if flags.Group != "" {
 		resource.ResourceType = sarama.AclResourceGroup
 		resource.ResourceName = flags.Group
-		resource.ResourcePatternType = patternTypeFromString(flags.PatternType)
+	} else if flags.TransactionalID != "" {
+		resource.ResourceType = sarama.AclResourceTransactionalID
+		resource.ResourceName = flags.TransactionalID
 	} else {
 		resource.ResourceType = sarama.AclResourceCluster
 		resource.ResourceName = "kafka-cluster"
-		resource.ResourcePatternType = patternTypeFromString(flags.PatternType)
 	}
 
 	permissionType := sarama.AclPermissionAllow
@@ -222,44 +333,20 @@ func (operation *Operation) CreateACL(flags CreateACLFlags) error {
 		permissionType = sarama.AclPermissionDeny
 	}
 
-	acls := make([]sarama.Acl, 0)
+	resourceAcls := &sarama.ResourceAcls{Resource: resource}
 
 	for _, host := range flags.Hosts {
 		for _, operation := range flags.Operations {
-			acl := sarama.Acl{
+			resourceAcls.Acls = append(resourceAcls.Acls, &sarama.Acl{
 				Principal:      flags.Principal,
 				Host:           host,
 				Operation:      operationFromString(operation),
 				PermissionType: permissionType,
-			}
-			acls = append(acls, acl)
-		}
-	}
-
-	resourceACL := ResourceACLEntry{
-		ResourceType: resourceTypeToString(resource.ResourceType),
-		ResourceName: resource.ResourceName,
-		PatternType:  patternTypeToString(resource.ResourcePatternType),
-		Acls:         make([]Entry, 0),
-	}
-
-	for _, acl := range acls {
-
-		if !flags.ValidateOnly {
-			if err = admin.CreateACLs([]*sarama.ResourceAcls{{Resource: resource, Acls: []*sarama.Acl{&acl}}}); err != nil {
-				return errors.Wrap(err, "failed to create acl")
-			}
+			})
 		}
-
-		resourceACL.Acls = append(resourceACL.Acls, Entry{
-			Principal:      acl.Principal,
-			Host:           acl.Host,
-			Operation:      operationToString(acl.Operation),
-			PermissionType: permissionTypeToString(acl.PermissionType),
-		})
 	}
 
-	return printResourceAcls("", resourceACL)
+	return []*sarama.ResourceAcls{resourceAcls}, nil
 }
 
 func (operation *Operation) DeleteACL(flags DeleteACLFlags) error {
@@ -271,6 +358,10 @@ func (operation *Operation) DeleteACL(flags DeleteACLFlags) error {
 		matchingACL []sarama.MatchingAcl
 	)
 
+	if flags.Role == "" && (flags.Topic != "" || flags.Group != "" || flags.TransactionalID != "" || flags.Idempotent) {
+		return errors.New("--topic, --group, --transactional-id and --idempotent can only be used together with --role")
+	}
+
 	if ctx, err = internal.CreateClientContext(); err != nil {
 		return err
 	}
@@ -279,6 +370,14 @@ func (operation *Operation) DeleteACL(flags DeleteACLFlags) error {
 		return errors.Wrap(err, "failed to create cluster admin")
 	}
 
+	if flags.File != "" {
+		return deleteACLsFromFile(admin, flags)
+	}
+
+	if flags.Role != "" {
+		return deleteRoleACLs(admin, flags)
+	}
+
 	if flags.Operation == "" {
 		return errors.New("no operation has been specified")
 	}
@@ -349,6 +448,107 @@ func (operation *Operation) DeleteACL(flags DeleteACLFlags) error {
 	return printResourceAcls("", aclList...)
 }
 
+func deleteRoleACLs(admin sarama.ClusterAdmin, flags DeleteACLFlags) error {
+
+	if flags.Principal == "" {
+		return errors.New("principal must be set")
+	}
+
+	if flags.Operation != "" || flags.Topics || flags.Groups || flags.Cluster || flags.Deny {
+		return errors.New("--operation, --topics, --groups, --cluster and --deny cannot be used together with --role")
+	}
+
+	if flags.PatternType == "" {
+		flags.PatternType = "literal"
+	}
+
+	if flags.Host == "" {
+		flags.Host = "*"
+	}
+
+	resourceAcls, err := roleACLs(CreateACLFlags{
+		Principal:       flags.Principal,
+		Hosts:           []string{flags.Host},
+		Topic:           flags.Topic,
+		Group:           flags.Group,
+		PatternType:     flags.PatternType,
+		Role:            flags.Role,
+		TransactionalID: flags.TransactionalID,
+		Idempotent:      flags.Idempotent,
+	})
+	if err != nil {
+		return err
+	}
+
+	aclList, err := deleteACLs(admin, resourceAcls, flags.ValidateOnly)
+	if err != nil {
+		return err
+	}
+
+	return printResourceAcls("", aclList...)
+}
+
+func deleteACLsFromFile(admin sarama.ClusterAdmin, flags DeleteACLFlags) error {
+
+	if flags.Operation != "" || flags.PatternType != "" || flags.Topics || flags.Groups || flags.Cluster ||
+		flags.Allow || flags.Deny || flags.Principal != "" || flags.Host != "" || flags.Role != "" {
+		return errors.New("--file cannot be used together with other acl flags")
+	}
+
+	resourceAcls, err := readACLFile(flags.File)
+	if err != nil {
+		return err
+	}
+
+	aclList, err := deleteACLs(admin, resourceAcls, flags.ValidateOnly)
+	if err != nil {
+		return err
+	}
+
+	return printResourceAcls("", aclList...)
+}
+
+// deleteACLs deletes exactly the given acls
+func deleteACLs(admin sarama.ClusterAdmin, resourceAcls []*sarama.ResourceAcls, validateOnly bool) ([]ResourceACLEntry, error) {
+
+	aclList := make([]ResourceACLEntry, 0)
+
+	for _, resourceAcl := range resourceAcls {
+		for _, acl := range resourceAcl.Acls {
+			filter := sarama.AclFilter{
+				ResourceType:              resourceAcl.ResourceType,
+				ResourceName:              &resourceAcl.ResourceName,
+				ResourcePatternTypeFilter: resourceAcl.ResourcePatternType,
+				Principal:                 &acl.Principal,
+				Host:                      &acl.Host,
+				Operation:                 acl.Operation,
+				PermissionType:            acl.PermissionType,
+			}
+
+			matchingACL, err := admin.DeleteACL(filter, validateOnly)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to delete acl")
+			}
+
+			for _, match := range matchingACL {
+				aclList = append(aclList, ResourceACLEntry{
+					ResourceType: resourceTypeToString(match.ResourceType),
+					ResourceName: match.ResourceName,
+					PatternType:  patternTypeToString(match.ResourcePatternType),
+					Acls: []Entry{{
+						Principal:      match.Principal,
+						Host:           match.Host,
+						Operation:      operationToString(match.Operation),
+						PermissionType: permissionTypeToString(match.PermissionType),
+					}},
+				})
+			}
+		}
+	}
+
+	return aclList, nil
+}
+
 func xor(values ...bool) bool {
 	and := true
 	or := false
@@ -0,0 +1,107 @@
+package acl
+
+import (
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+var Roles = []string{"producer", "consumer", "admin"}
+
+// roleACLs expands a role to the acls that are required for producing, consuming or administrating a topic.
+// The acls are the same as the ones created by kafka-acls.sh with --producer or --consumer.
+func roleACLs(flags CreateACLFlags) ([]*sarama.ResourceAcls, error) {
+
+	if flags.Topic == "" {
+		return nil, errors.New("--topic has to be provided for roles")
+	}
+
+	if flags.Cluster {
+		return nil, errors.New("--cluster cannot be used together with --role")
+	}
+
+	var (
+		topicOperations           []sarama.AclOperation
+		groupOperations           []sarama.AclOperation
+		transactionalIDOperations []sarama.AclOperation
+		clusterOperations         []sarama.AclOperation
+	)
+
+	switch strings.ToLower(flags.Role) {
+	case "producer":
+		if flags.Group != "" {
+			return nil, errors.New("--group cannot be used with role producer")
+		}
+		topicOperations = []sarama.AclOperation{sarama.AclOperationWrite, sarama.AclOperationDescribe, sarama.AclOperationCreate}
+		if flags.TransactionalID != "" {
+			transactionalIDOperations = []sarama.AclOperation{sarama.AclOperationWrite, sarama.AclOperationDescribe}
+		}
+		if flags.Idempotent {
+			clusterOperations = []sarama.AclOperation{sarama.AclOperationIdempotentWrite}
+		}
+	case "consumer":
+		if flags.Group == "" {
+			return nil, errors.New("--group has to be provided for role consumer")
+		}
+		if flags.TransactionalID != "" || flags.Idempotent {
+			return nil, errors.New("--transactional-id and --idempotent can only be used with role producer")
+		}
+		topicOperations = []sarama.AclOperation{sarama.AclOperationRead, sarama.AclOperationDescribe}
+		groupOperations = []sarama.AclOperation{sarama.AclOperationRead}
+	case "admin":
+		if flags.Idempotent {
+			return nil, errors.New("--idempotent can only be used with role producer")
+		}
+		topicOperations = []sarama.AclOperation{sarama.AclOperationAll}
+		if flags.Group != "" {
+			groupOperations = []sarama.AclOperation{sarama.AclOperationAll}
+		}
+		if flags.TransactionalID != "" {
+			transactionalIDOperations = []sarama.AclOperation{sarama.AclOperationAll}
+		}
+	default:
+		return nil, errors.Errorf("unknown role: %s (one of %s)", flags.Role, strings.Join(Roles, ", "))
+	}
+
+	patternType := patternTypeFromString(flags.PatternType)
+
+	resourceAcls := []*sarama.ResourceAcls{
+		bindings(sarama.AclResourceTopic, flags.Topic, patternType, flags.Principal, flags.Hosts, topicOperations),
+	}
+	if len(groupOperations) > 0 {
+		resourceAcls = append(resourceAcls, bindings(sarama.AclResourceGroup, flags.Group, patternType,
+			flags.Principal, flags.Hosts, groupOperations))
+	}
+	if len(transactionalIDOperations) > 0 {
+		resourceAcls = append(resourceAcls, bindings(sarama.AclResourceTransactionalID, flags.TransactionalID, patternType,
+			flags.Principal, flags.Hosts, transactionalIDOperations))
+	}
+	if len(clusterOperations) > 0 {
+		resourceAcls = append(resourceAcls, bindings(sarama.AclResourceCluster, "kafka-cluster", sarama.AclPatternLiteral,
+			flags.Principal, flags.Hosts, clusterOperations))
+	}
+
+	return resourceAcls, nil
+}
+
+func bindings(resourceType sarama.AclResourceType, name string, patternType sarama.AclResourcePatternType,
+	principal string, hosts []string, operations []sarama.AclOperation) *sarama.ResourceAcls {
+
+	resourceAcls := &sarama.ResourceAcls{
+		Resource: sarama.Resource{ResourceType: resourceType, ResourceName: name, ResourcePatternType: patternType},
+	}
+
+	for _, host := range hosts {
+		for _, operation := range operations {
+			resourceAcls.Acls = append(resourceAcls.Acls, &sarama.Acl{
+				Principal:      principal,
+				Host:           host,
+				Operation:      operation,
+				PermissionType: sarama.AclPermissionAllow,
+			})
+		}
+	}
+
+	return resourceAcls
+}
@@ -0,0 +1,158 @@
+package acl
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func operations(resourceAcls *sarama.ResourceAcls) []sarama.AclOperation {
+	result := make([]sarama.AclOperation, 0, len(resourceAcls.Acls))
+	for _, acl := range resourceAcls.Acls {
+		result = append(result, acl.Operation)
+	}
+	return result
+}
+
+func assertOperations(t *testing.T, resourceAcls *sarama.ResourceAcls, resourceType sarama.AclResourceType, name string, want ...sarama.AclOperation) {
+	t.Helper()
+	if resourceAcls.ResourceType != resourceType || resourceAcls.ResourceName != name {
+		t.Fatalf("unexpected resource %s %s", resourceTypeToString(resourceAcls.ResourceType), resourceAcls.ResourceName)
+	}
+	got := operations(resourceAcls)
+	if len(got) != len(want) {
+		t.Fatalf("expected operations %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected operations %v, got %v", want, got)
+		}
+	}
+}
+
+func TestRoleACLsProducer(t *testing.T) {
+
+	resourceAcls, err := roleACLs(CreateACLFlags{Role: "producer", Principal: "User:svc", Hosts: []string{"*"},
+		Topic: "orders", TransactionalID: "tx", Idempotent: true, PatternType: "literal"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resourceAcls) != 3 {
+		t.Fatalf("expected 3 resources, got %d", len(resourceAcls))
+	}
+	assertOperations(t, resourceAcls[0], sarama.AclResourceTopic, "orders",
+		sarama.AclOperationWrite, sarama.AclOperationDescribe, sarama.AclOperationCreate)
+	assertOperations(t, resourceAcls[1], sarama.AclResourceTransactionalID, "tx",
+		sarama.AclOperationWrite, sarama.AclOperationDescribe)
+	assertOperations(t, resourceAcls[2], sarama.AclResourceCluster, "kafka-cluster",
+		sarama.AclOperationIdempotentWrite)
+
+	for _, acl := range resourceAcls[0].Acls {
+		if acl.Principal != "User:svc" || acl.Host != "*" || acl.PermissionType != sarama.AclPermissionAllow {
+			t.Fatalf("unexpected acl: %+v", acl)
+		}
+	}
+}
+
+func TestRoleACLsConsumer(t *testing.T) {
+
+	resourceAcls, err := roleACLs(CreateACLFlags{Role: "consumer", Principal: "User:svc", Hosts: []string{"host-a", "host-b"},
+		Topic: "orders-", Group: "g", PatternType: "prefixed"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resourceAcls) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resourceAcls))
+	}
+	assertOperations(t, resourceAcls[0], sarama.AclResourceTopic, "orders-",
+		sarama.AclOperationRead, sarama.AclOperationDescribe, sarama.AclOperationRead, sarama.AclOperationDescribe)
+	assertOperations(t, resourceAcls[1], sarama.AclResourceGroup, "g", sarama.AclOperationRead, sarama.AclOperationRead)
+
+	if resourceAcls[0].ResourcePatternType != sarama.AclPatternPrefixed {
+		t.Fatalf("expected prefixed pattern, got %s", patternTypeToString(resourceAcls[0].ResourcePatternType))
+	}
+}
+
+func TestRoleACLsValidation(t *testing.T) {
+
+	for name, flags := range map[string]CreateACLFlags{
+		"unknown role":         {Role: "reader", Topic: "orders"},
+		"missing topic":        {Role: "producer"},
+		"consumer needs group": {Role: "consumer", Topic: "orders"},
+		"consumer idempotent":  {Role: "consumer", Topic: "orders", Group: "g", Idempotent: true},
+		"producer with group":  {Role: "producer", Topic: "orders", Group: "g"},
+		"cluster with role":    {Role: "admin", Topic: "orders", Cluster: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := roleACLs(flags); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+type deleteACLAdminStub struct {
+	sarama.ClusterAdmin
+	acls    []sarama.ResourceAcls
+	deleted int
+}
+
+func (admin *deleteACLAdminStub) ListAcls(sarama.AclFilter) ([]sarama.ResourceAcls, error) {
+	return admin.acls, nil
+}
+
+// DeleteACL returns all acls as matching, they are only deleted without validateOnly
+func (admin *deleteACLAdminStub) DeleteACL(_ sarama.AclFilter, validateOnly bool) ([]sarama.MatchingAcl, error) {
+	if !validateOnly {
+		admin.deleted++
+	}
+	var matching []sarama.MatchingAcl
+	for _, resourceAcls := range admin.acls {
+		for _, acl := range resourceAcls.Acls {
+			matching = append(matching, sarama.MatchingAcl{Resource: resourceAcls.Resource, Acl: *acl})
+		}
+	}
+	return matching, nil
+}
+
+func TestDeleteACLsValidateOnlyDoesNotDelete(t *testing.T) {
+
+	resourceAcls, err := roleACLs(CreateACLFlags{Principal: "User:alice", Hosts: []string{"*"}, Topic: "orders", PatternType: "literal", Role: "producer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stub := &deleteACLAdminStub{acls: []sarama.ResourceAcls{*resourceAcls[0]}}
+
+	aclList, err := deleteACLs(stub, resourceAcls, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stub.deleted != 0 {
+		t.Fatalf("expected no deletion with validateOnly, got %d", stub.deleted)
+	}
+	if len(aclList) == 0 || aclList[0].ResourceName != "orders" {
+		t.Fatalf("expected matching acls to be listed, got %+v", aclList)
+	}
+
+	if _, err = deleteACLs(stub, resourceAcls, false); err != nil {
+		t.Fatal(err)
+	}
+	if stub.deleted == 0 {
+		t.Fatal("expected acls to be deleted without validateOnly")
+	}
+}
+
+func TestDeleteACLRoleFlagsRequireRole(t *testing.T) {
+	for _, flags := range []DeleteACLFlags{
+		{Operation: "read", Topics: true, Topic: "orders"},
+		{Operation: "read", Groups: true, Group: "my-group"},
+	} {
+		err := (&Operation{}).DeleteACL(flags)
+		if err == nil || err.Error() != "--topic, --group, --transactional-id and --idempotent can only be used together with --role" {
+			t.Fatalf("expected error for flags %+v, got %v", flags, err)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package acl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/pkg/errors"
+)
+
+type CheckAccessFlags struct {
+	Principal       string
+	Host            string
+	Operation       string
+	Topic           string
+	Group           string
+	Cluster         bool
+	TransactionalID string
+	OutputFormat    string
+}
+
+type AccessDecision struct {
+	Principal     string             `json:"principal" yaml:"principal"`
+	Host          string             `json:"host" yaml:"host"`
+	Operation     string             `json:"operation" yaml:"operation"`
+	ResourceType  string             `json:"resourceType" yaml:"resourceType"`
+	ResourceName  string             `json:"resourceName" yaml:"resourceName"`
+	Allowed       bool               `json:"allowed" yaml:"allowed"`
+	HostDependent bool               `json:"hostDependent" yaml:"hostDependent"`
+	Reason        string             `json:"reason" yaml:"reason"`
+	MatchingAcls  []ResourceACLEntry `json:"matchingAcls" yaml:"matchingAcls"`
+}
+
+type accessRequest struct {
+	principal    string
+	host         string
+	operation    sarama.AclOperation
+	resourceType sarama.AclResourceType
+	resourceName string
+}
+
+// CheckAccess evaluates the acls of a resource the same way the kafka authorizer does and prints
+// whether the principal is allowed to perform the operation.
+func (operation *Operation) CheckAccess(flags CheckAccessFlags) error {
+
+	var (
+		ctx   internal.ClientContext
+		err   error
+		admin sarama.ClusterAdmin
+		acls  []sarama.ResourceAcls
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown output format: %s", flags.OutputFormat)
+	}
+
+	if flags.Principal == "" {
+		return errors.New("principal must be set")
+	}
+
+	request := accessRequest{principal: flags.Principal, host: flags.Host, operation: operationFromString(flags.Operation)}
+
+	switch request.operation {
+	case sarama.AclOperationUnknown, sarama.AclOperationAny, sarama.AclOperationAll:
+		return errors.Errorf("operation has to be a single operation like read or write: %s", flags.Operation)
+	}
+
+	if !xor(flags.Topic != "", flags.Group != "", flags.Cluster, flags.TransactionalID != "") {
+		return errors.New("either --topic or --group or --cluster or --transactional-id has to be provided")
+	}
+
+	if flags.Topic != "" {
+		request.resourceType = sarama.AclResourceTopic
+		request.resourceName = flags.Topic
+	} else if flags.Group != "" {
+		request.resourceType = sarama.AclResourceGroup
+		request.resourceName = flags.Group
+	} else if flags.TransactionalID != "" {
+		request.resourceType = sarama.AclResourceTransactionalID
+		request.resourceName = flags.TransactionalID
+	} else {
+		request.resourceType = sarama.AclResourceCluster
+		request.resourceName = "kafka-cluster"
+	}
+
+	if ctx, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&ctx); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	filter := sarama.AclFilter{
+		ResourceType:              request.resourceType,
+		ResourcePatternTypeFilter: sarama.AclPatternAny,
+		PermissionType:            sarama.AclPermissionAny,
+		Operation:                 sarama.AclOperationAny,
+	}
+
+	if acls, err = admin.ListAcls(filter); err != nil {
+		return errors.Wrap(err, "failed to list acls")
+	}
+
+	decision := evaluateAccess(request, acls)
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(decision, flags.OutputFormat)
+	}
+
+	result := "DENIED"
+	if decision.Allowed {
+		result = "ALLOWED"
+	}
+	output.PrintStrings(fmt.Sprintf("%s: %s", result, decision.Reason))
+
+	if len(decision.MatchingAcls) > 0 {
+		output.PrintStrings("")
+		return printResourceAcls("", decision.MatchingAcls...)
+	}
+	return nil
+}
+
+// evaluateAccess follows the semantics of the kafka authorizer: a matching deny acl takes precedence over
+// allow acls, and without a matching allow acl the access is denied. Super users are not taken into account.
+func evaluateAccess(request accessRequest, acls []sarama.ResourceAcls) AccessDecision {
+
+	decision := AccessDecision{
+		Principal:    request.principal,
+		Host:         request.host,
+		Operation:    operationToString(request.operation),
+		ResourceType: resourceTypeToString(request.resourceType),
+		ResourceName: request.resourceName,
+		MatchingAcls: make([]ResourceACLEntry, 0),
+	}
+
+	var (
+		resourceHasAcls bool
+		allowed         bool
+		denied          bool
+		allowedForHosts bool
+		deniedForHosts  bool
+	)
+
+	for _, resourceAcls := range acls {
+		if !resourceMatches(resourceAcls.Resource, request) {
+			continue
+		}
+		resourceHasAcls = resourceHasAcls || len(resourceAcls.Acls) > 0
+
+		entry := ResourceACLEntry{
+			ResourceType: resourceTypeToString(resourceAcls.ResourceType),
+			ResourceName: resourceAcls.ResourceName,
+			PatternType:  patternTypeToString(resourceAcls.ResourcePatternType),
+			Acls:         make([]Entry, 0),
+		}
+
+		for _, acl := range resourceAcls.Acls {
+			if !aclMatches(acl, request) {
+				continue
+			}
+			// without a host, acls for specific hosts make the decision depend on the host
+			hostSpecific := request.host == "" && acl.Host != "*"
+			switch {
+			case acl.PermissionType == sarama.AclPermissionDeny && hostSpecific:
+				deniedForHosts = true
+			case acl.PermissionType == sarama.AclPermissionDeny:
+				denied = true
+			case hostSpecific:
+				allowedForHosts = true
+			default:
+				allowed = true
+			}
+			entry.Acls = append(entry.Acls, Entry{
+				Principal:      acl.Principal,
+				Host:           acl.Host,
+				Operation:      operationToString(acl.Operation),
+				PermissionType: permissionTypeToString(acl.PermissionType),
+			})
+		}
+
+		if len(entry.Acls) > 0 {
+			decision.MatchingAcls = append(decision.MatchingAcls, entry)
+		}
+	}
+
+	switch {
+	case denied:
+		decision.Reason = "denied by acl"
+	case deniedForHosts:
+		decision.HostDependent = true
+		decision.Reason = "denied by acl for specific hosts (the decision depends on the host, use --host)"
+	case allowed:
+		decision.Allowed = true
+		decision.Reason = "allowed by acl"
+	case allowedForHosts:
+		decision.HostDependent = true
+		decision.Reason = "allowed only by acls for specific hosts (the decision depends on the host, use --host)"
+	case resourceHasAcls:
+		decision.Reason = "no acl allows the operation"
+	default:
+		decision.Reason = "no acls found for resource (allowed only if allow.everyone.if.no.acl.found=true)"
+	}
+
+	return decision
+}
+
+func resourceMatches(resource sarama.Resource, request accessRequest) bool {
+	if resource.ResourceType != request.resourceType {
+		return false
+	}
+	switch resource.ResourcePatternType {
+	case sarama.AclPatternLiteral:
+		return resource.ResourceName == "*" || resource.ResourceName == request.resourceName
+	case sarama.AclPatternPrefixed:
+		return strings.HasPrefix(request.resourceName, resource.ResourceName)
+	default:
+		return false
+	}
+}
+
+func aclMatches(acl *sarama.Acl, request accessRequest) bool {
+	if acl.Principal != request.principal && acl.Principal != "User:*" {
+		return false
+	}
+	if request.host != "" && acl.Host != request.host && acl.Host != "*" {
+		return false
+	}
+	if acl.Operation == sarama.AclOperationAll || acl.Operation == request.operation {
+		return true
+	}
+	// allow acls for these operations imply the permission to describe
+	if acl.PermissionType == sarama.AclPermissionAllow {
+		switch request.operation {
+		case sarama.AclOperationDescribe:
+			return acl.Operation == sarama.AclOperationRead || acl.Operation == sarama.AclOperationWrite ||
+				acl.Operation == sarama.AclOperationDelete || acl.Operation == sarama.AclOperationAlter
+		case sarama.AclOperationDescribeConfigs:
+			return acl.Operation == sarama.AclOperationAlterConfigs
+		}
+	}
+	return false
+}
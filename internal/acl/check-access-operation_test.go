@@ -0,0 +1,155 @@
+package acl
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func topicAcls(name string, patternType sarama.AclResourcePatternType, acls ...*sarama.Acl) sarama.ResourceAcls {
+	return sarama.ResourceAcls{
+		Resource: sarama.Resource{ResourceType: sarama.AclResourceTopic, ResourceName: name, ResourcePatternType: patternType},
+		Acls:     acls,
+	}
+}
+
+func allow(principal string, operation sarama.AclOperation) *sarama.Acl {
+	return &sarama.Acl{Principal: principal, Host: "*", Operation: operation, PermissionType: sarama.AclPermissionAllow}
+}
+
+func deny(principal string, operation sarama.AclOperation) *sarama.Acl {
+	return &sarama.Acl{Principal: principal, Host: "*", Operation: operation, PermissionType: sarama.AclPermissionDeny}
+}
+
+func TestEvaluateAccess(t *testing.T) {
+
+	readOrders := accessRequest{principal: "User:app", host: "*", operation: sarama.AclOperationRead,
+		resourceType: sarama.AclResourceTopic, resourceName: "orders"}
+	describeOrders := readOrders
+	describeOrders.operation = sarama.AclOperationDescribe
+
+	for _, test := range []struct {
+		name        string
+		request     accessRequest
+		acls        []sarama.ResourceAcls
+		wantAllowed bool
+		wantMatches int
+	}{
+		{
+			name:        "literal allow",
+			request:     readOrders,
+			acls:        []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral, allow("User:app", sarama.AclOperationRead))},
+			wantAllowed: true, wantMatches: 1,
+		},
+		{
+			name:        "prefixed allow",
+			request:     readOrders,
+			acls:        []sarama.ResourceAcls{topicAcls("ord", sarama.AclPatternPrefixed, allow("User:app", sarama.AclOperationRead))},
+			wantAllowed: true, wantMatches: 1,
+		},
+		{
+			name:    "prefix does not match",
+			request: readOrders,
+			acls:    []sarama.ResourceAcls{topicAcls("orders-", sarama.AclPatternPrefixed, allow("User:app", sarama.AclOperationRead))},
+		},
+		{
+			name:        "wildcard resource and principal",
+			request:     readOrders,
+			acls:        []sarama.ResourceAcls{topicAcls("*", sarama.AclPatternLiteral, allow("User:*", sarama.AclOperationAll))},
+			wantAllowed: true, wantMatches: 1,
+		},
+		{
+			name:    "deny takes precedence",
+			request: readOrders,
+			acls: []sarama.ResourceAcls{
+				topicAcls("orders", sarama.AclPatternLiteral, allow("User:app", sarama.AclOperationRead)),
+				topicAcls("*", sarama.AclPatternLiteral, deny("User:*", sarama.AclOperationRead)),
+			},
+			wantMatches: 2,
+		},
+		{
+			name:    "other principal",
+			request: readOrders,
+			acls:    []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral, allow("User:other", sarama.AclOperationRead))},
+		},
+		{
+			name:        "read implies describe",
+			request:     describeOrders,
+			acls:        []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral, allow("User:app", sarama.AclOperationRead))},
+			wantAllowed: true, wantMatches: 1,
+		},
+		{
+			name:    "deny read does not deny describe",
+			request: describeOrders,
+			acls: []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral,
+				allow("User:app", sarama.AclOperationDescribe), deny("User:app", sarama.AclOperationRead))},
+			wantAllowed: true, wantMatches: 1,
+		},
+		{
+			name:    "host mismatch",
+			request: readOrders,
+			acls: []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral,
+				&sarama.Acl{Principal: "User:app", Host: "10.0.0.1", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow})},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			decision := evaluateAccess(test.request, test.acls)
+			if decision.Allowed != test.wantAllowed {
+				t.Fatalf("expected allowed=%v, got %v (%s)", test.wantAllowed, decision.Allowed, decision.Reason)
+			}
+			if len(decision.MatchingAcls) != test.wantMatches {
+				t.Fatalf("expected %d matching acls, got %v", test.wantMatches, decision.MatchingAcls)
+			}
+		})
+	}
+}
+
+func TestEvaluateAccessWithoutAcls(t *testing.T) {
+
+	request := accessRequest{principal: "User:app", host: "*", operation: sarama.AclOperationRead,
+		resourceType: sarama.AclResourceTopic, resourceName: "orders"}
+
+	decision := evaluateAccess(request, nil)
+	if decision.Allowed || decision.Reason != "no acls found for resource (allowed only if allow.everyone.if.no.acl.found=true)" {
+		t.Fatalf("unexpected decision: %+v", decision)
+	}
+
+	decision = evaluateAccess(request, []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral, allow("User:other", sarama.AclOperationRead))})
+	if decision.Allowed || decision.Reason != "no acl allows the operation" {
+		t.Fatalf("unexpected decision: %+v", decision)
+	}
+}
+
+func TestEvaluateAccessWithHostSpecificAcls(t *testing.T) {
+
+	acls := []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral,
+		allow("User:app", sarama.AclOperationRead),
+		&sarama.Acl{Principal: "User:app", Host: "10.0.0.1", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionDeny})}
+
+	request := accessRequest{principal: "User:app", operation: sarama.AclOperationRead,
+		resourceType: sarama.AclResourceTopic, resourceName: "orders"}
+
+	// without a host, the host specific deny makes the decision depend on the host
+	decision := evaluateAccess(request, acls)
+	if decision.Allowed || !decision.HostDependent || len(decision.MatchingAcls) != 1 || len(decision.MatchingAcls[0].Acls) != 2 {
+		t.Fatalf("expected host dependent deny, got: %+v", decision)
+	}
+
+	request.host = "10.0.0.1"
+	if decision = evaluateAccess(request, acls); decision.Allowed || decision.HostDependent {
+		t.Fatalf("expected deny for host 10.0.0.1, got: %+v", decision)
+	}
+
+	request.host = "10.0.0.2"
+	if decision = evaluateAccess(request, acls); !decision.Allowed || decision.HostDependent {
+		t.Fatalf("expected allow for host 10.0.0.2, got: %+v", decision)
+	}
+
+	// a host specific allow does not allow the access without a host
+	request.host = ""
+	acls = []sarama.ResourceAcls{topicAcls("orders", sarama.AclPatternLiteral,
+		&sarama.Acl{Principal: "User:app", Host: "10.0.0.1", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow})}
+	if decision = evaluateAccess(request, acls); decision.Allowed || !decision.HostDependent {
+		t.Fatalf("expected host dependent decision, got: %+v", decision)
+	}
+}
@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/global"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+const redacted = "***"
+
+// sensitiveFlags matches names of flags and config entries whose values must not be written to the audit log
+var sensitiveFlags = regexp.MustCompile(`(?i)password|secret|token|credential|passphrase|jaas|hmac`)
+
+// payloadFlags contain message payloads, which may contain personal data. Only their length is logged.
+var payloadFlags = regexp.MustCompile(`^(key|value|header)$`)
+
+// Record is a single entry of the audit log.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Context   string    `json:"context"`
+	Brokers   []string  `json:"brokers"`
+	Command   string    `json:"command"`
+	Args      []string  `json:"args"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Enabled returns true if an audit log is configured in the global config.
+func Enabled() bool {
+	return viper.GetString("audit.file") != "" || viper.GetBool("audit.syslog")
+}
+
+// NewRecord creates an audit record for the execution of the command.
+func NewRecord(cmd *cobra.Command, args []string, err error) Record {
+
+	record := Record{
+		Timestamp: time.Now(),
+		User:      currentUser(),
+		Command:   cmd.CommandPath(),
+		Args:      commandArgs(cmd, args),
+		Result:    ResultSuccess,
+	}
+
+	if contextName, contextErr := global.GetCurrentContext(); contextErr == nil {
+		record.Context = contextName
+		record.Brokers = viper.GetStringSlice("contexts." + contextName + ".brokers")
+	}
+
+	if err != nil {
+		record.Result = ResultFailure
+		record.Error = err.Error()
+	}
+
+	return record
+}
+
+// Log appends the record to the configured audit file and/or syslog.
+func Log(record Record) error {
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit record")
+	}
+
+	if filename := viper.GetString("audit.file"); filename != "" {
+		if err := appendToFile(filename, line); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("audit.syslog") {
+		if err := writeToSyslog(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func appendToFile(filename string, line []byte) error {
+
+	path, err := resolveAuditFile(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "unable to create directory for audit log %s", path)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open audit log %s", path)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return errors.Wrapf(err, "unable to write audit log %s", path)
+	}
+	return nil
+}
+
+// resolveAuditFile expands the home directory. Relative paths are resolved relative to the config file.
+func resolveAuditFile(filename string) (string, error) {
+
+	if strings.HasPrefix(filename, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", errors.Wrap(err, "unable to resolve home directory for audit log")
+		}
+		return filepath.Join(home, filename[2:]), nil
+	}
+
+	if !filepath.IsAbs(filename) && viper.ConfigFileUsed() != "" {
+		return filepath.Join(filepath.Dir(viper.ConfigFileUsed()), filename), nil
+	}
+
+	return filename, nil
+}
+
+func currentUser() string {
+	if usr, err := user.Current(); err == nil {
+		return usr.Username
+	}
+	return os.Getenv("USER")
+}
+
+// commandArgs returns the arguments and all flags that have been set, with values of sensitive flags redacted
+// and payloads replaced by their length.
+func commandArgs(cmd *cobra.Command, args []string) []string {
+
+	commandArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		commandArgs = append(commandArgs, redactConfig(arg))
+	}
+
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed {
+			return
+		}
+		values := []string{flag.Value.String()}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			values = append([]string{}, sliceValue.GetSlice()...)
+		}
+		for i := range values {
+			if sensitiveFlags.MatchString(flag.Name) {
+				values[i] = redacted
+			} else if payloadFlags.MatchString(flag.Name) {
+				values[i] = fmt.Sprintf("<%d bytes>", len(values[i]))
+			} else {
+				values[i] = redactConfig(values[i])
+			}
+		}
+		value := strings.Join(values, ",")
+		commandArgs = append(commandArgs, fmt.Sprintf("--%s=%s", flag.Name, value))
+	})
+
+	return commandArgs
+}
+
+// redactConfig redacts the value of config entries like `sasl.jaas.config=...`
+func redactConfig(value string) string {
+	if key, _, found := strings.Cut(value, "="); found && sensitiveFlags.MatchString(key) {
+		return key + "=" + redacted
+	}
+	return value
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestCommandArgsRedactsSecrets(t *testing.T) {
+
+	var (
+		password string
+		hmac     string
+		configs  []string
+		value    string
+		headers  []string
+	)
+
+	cmd := &cobra.Command{Use: "user", RunE: func(_ *cobra.Command, _ []string) error { return nil }}
+	cmd.Flags().StringVarP(&password, "password", "p", "", "")
+	cmd.Flags().StringVar(&hmac, "hmac", "", "")
+	cmd.Flags().StringArrayVarP(&configs, "config", "c", nil, "")
+	cmd.Flags().StringVar(&value, "value", "", "")
+	cmd.Flags().StringArrayVar(&headers, "header", nil, "")
+
+	if err := cmd.ParseFlags([]string{"--password", "secret", "--config", "retention.ms=100",
+		"--config", "sasl.jaas.config=secret", "--hmac", "c2VjcmV0", "--value", "personal data",
+		"--header", "a:b", "--header", "user:jane"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	args := commandArgs(cmd, []string{"my-user", "ssl.keystore.password=secret"})
+
+	expected := []string{"my-user", "ssl.keystore.password=***", "--config=retention.ms=100,sasl.jaas.config=***",
+		"--header=<3 bytes>,<9 bytes>", "--hmac=***", "--password=***", "--value=<13 bytes>"}
+
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %v, got %v", expected, args)
+	}
+
+	if configs[1] != "sasl.jaas.config=secret" {
+		t.Fatalf("flag values must not be modified: %v", configs)
+	}
+}
+
+func TestLogAppendsRecordsToFile(t *testing.T) {
+
+	auditFile := filepath.Join(t.TempDir(), "logs", "audit.log")
+	viper.Set("audit.file", auditFile)
+	t.Cleanup(func() { viper.Set("audit.file", "") })
+
+	if !Enabled() {
+		t.Fatalf("audit log should be enabled")
+	}
+
+	records := []Record{
+		{Command: "kafkactl create topic", Args: []string{"a"}, Result: ResultSuccess},
+		{Command: "kafkactl delete topic", Args: []string{"b"}, Result: ResultFailure, Error: "failed"},
+	}
+
+	for _, record := range records {
+		if err := Log(record); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	file, err := os.Open(auditFile)
+	if err != nil {
+		t.Fatalf("unable to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	for _, expected := range records {
+		var record Record
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("unable to decode audit record: %v", err)
+		}
+		if record.Command != expected.Command || record.Result != expected.Result || record.Error != expected.Error {
+			t.Fatalf("expected %v, got %v", expected, record)
+		}
+	}
+}
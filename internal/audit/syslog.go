@@ -0,0 +1,20 @@
+//go:build !windows
+
+package audit
+
+import (
+	"log/syslog"
+
+	"github.com/pkg/errors"
+)
+
+func writeToSyslog(line []byte) error {
+
+	writer, err := syslog.New(syslog.LOG_NOTICE|syslog.LOG_USER, "kafkactl")
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to syslog")
+	}
+	defer writer.Close()
+
+	return writer.Notice(string(line))
+}
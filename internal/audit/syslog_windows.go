@@ -0,0 +1,9 @@
+package audit
+
+import (
+	"github.com/pkg/errors"
+)
+
+func writeToSyslog(_ []byte) error {
+	return errors.New("audit logging to syslog is not supported on windows")
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/aws/aws-msk-iam-sasl-signer-go/signer"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+)
+
+type AWSConfig struct {
+	Region          string
+	Profile         string
+	RoleArn         string
+	RoleSessionName string
+}
+
+type awsMskIamTokenProvider struct {
+	region      string
+	credentials aws.CredentialsProvider
+}
+
+// Token creates a SigV4 signed token for SASL/OAUTHBEARER authentication with MSK IAM.
+func (p *awsMskIamTokenProvider) Token() (*sarama.AccessToken, error) {
+	token, _, err := signer.GenerateAuthTokenFromCredentialsProvider(context.Background(), p.region, p.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate aws msk iam token: %w", err)
+	}
+	return &sarama.AccessToken{Token: token}, nil
+}
+
+// NewAWSMskIamTokenProvider creates a token provider for MSK IAM authentication. The credentials are resolved
+// with the default credential chain of the aws sdk (environment, shared config/profile, web identity, container
+// and instance roles). If a role arn is configured, the role is assumed with these credentials.
+func NewAWSMskIamTokenProvider(awsConfig AWSConfig) (sarama.AccessTokenProvider, error) {
+
+	ctx := context.Background()
+
+	region := awsConfig.Region
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		return nil, errors.New("aws region has to be configured with sasl.aws.region or AWS_REGION")
+	}
+
+	output.Debugf("using aws-msk-iam (region=%s, profile=%s, roleArn=%s)", region, awsConfig.Profile, awsConfig.RoleArn)
+
+	options := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if awsConfig.Profile != "" {
+		options = append(options, config.WithSharedConfigProfile(awsConfig.Profile))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	credentials := cfg.Credentials
+	if awsConfig.RoleArn != "" {
+		sessionName := awsConfig.RoleSessionName
+		if sessionName == "" {
+			sessionName = "kafkactl"
+		}
+		credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), awsConfig.RoleArn,
+			func(o *stscreds.AssumeRoleOptions) {
+				o.RoleSessionName = sessionName
+			}))
+	}
+
+	return &awsMskIamTokenProvider{region: region, credentials: credentials}, nil
+}
@@ -0,0 +1,413 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/credential"
+	"github.com/deviceinsight/kafkactl/v5/internal/global"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+)
+
+// knownExtensions restores the case of sasl extensions, which are lowercased by viper.
+var knownExtensions = map[string]string{
+	"logicalcluster": "logicalCluster",
+	"identitypoolid": "identityPoolId",
+}
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	maxRefreshBuffer    = time.Minute
+	// defaultLifetime is used if the token response does not contain expires_in
+	defaultLifetime = 5 * time.Minute
+)
+
+type clientCredentialsTokenProvider struct {
+	client       *http.Client
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	basicAuth    bool
+	privateKey   crypto.Signer
+	keyID        string
+	scopes       []string
+	audience     string
+	extensions   map[string]string
+	now          func() time.Time
+
+	mutex     sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+type clientCredentialsTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns the cached token or fetches a new one if the cached token is about to expire.
+func (p *clientCredentialsTokenProvider) Token() (*sarama.AccessToken, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.token == "" || !p.now().Before(p.refreshAt) {
+		if err := p.fetchToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &sarama.AccessToken{Token: p.token, Extensions: p.extensions}, nil
+}
+
+func (p *clientCredentialsTokenProvider) fetchToken() error {
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(p.scopes) > 0 {
+		form.Set("scope", strings.Join(p.scopes, " "))
+	}
+	if p.audience != "" {
+		form.Set("audience", p.audience)
+	}
+
+	if p.privateKey != nil {
+		assertion, err := p.createClientAssertion()
+		if err != nil {
+			return fmt.Errorf("failed to create client assertion: %w", err)
+		}
+		form.Set("client_id", p.clientID)
+		form.Set("client_assertion_type", clientAssertionType)
+		form.Set("client_assertion", assertion)
+	} else if !p.basicAuth {
+		form.Set("client_id", p.clientID)
+		form.Set("client_secret", p.clientSecret)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, p.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("invalid token request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if p.privateKey == nil && p.basicAuth {
+		request.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(p.clientSecret))
+	}
+
+	output.Debugf("requesting token from %s (clientId=%s)", p.tokenURL, p.clientID)
+
+	requestTime := p.now()
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to request token from %s: %w", p.tokenURL, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to request token from %s: %s %s", p.tokenURL, response.Status, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResponse clientCredentialsTokenResponse
+	if err = json.Unmarshal(body, &tokenResponse); err != nil {
+		return fmt.Errorf("failed to parse token response: %w", err)
+	}
+
+	if tokenResponse.AccessToken == "" {
+		return errors.New("token response does not contain an access_token")
+	}
+
+	p.token = tokenResponse.AccessToken
+
+	// the token is refreshed before it expires, at most one minute and at least after half of its lifetime
+	lifetime := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultLifetime
+	}
+	p.refreshAt = requestTime.Add(lifetime - min(lifetime/2, maxRefreshBuffer))
+
+	output.Debugf("received token (expiresIn=%s)", lifetime)
+	return nil
+}
+
+// createClientAssertion creates a signed jwt to authenticate the client (RFC 7523).
+func (p *clientCredentialsTokenProvider) createClientAssertion() (string, error) {
+
+	algorithm := "RS256"
+	if _, ok := p.privateKey.(*ecdsa.PrivateKey); ok {
+		algorithm = "ES256"
+	}
+
+	header := map[string]string{"alg": algorithm, "typ": "JWT"}
+	if p.keyID != "" {
+		header["kid"] = p.keyID
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	now := p.now()
+	claims := map[string]any{
+		"iss": p.clientID,
+		"sub": p.clientID,
+		"aud": p.tokenURL,
+		"jti": base64.RawURLEncoding.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch key := p.privateKey.(type) {
+	case *rsa.PrivateKey:
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:]); err != nil {
+			return "", err
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return "", err
+		}
+		// jws uses the fixed size concatenation of r and s instead of asn.1
+		signature = append(padLeft(r, 32), padLeft(s, 32)...)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func padLeft(value *big.Int, size int) []byte {
+	bytes := value.Bytes()
+	padded := make([]byte, size)
+	copy(padded[size-len(bytes):], bytes)
+	return padded
+}
+
+func loadPrivateKey(path string) (crypto.Signer, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read private key: %w", err)
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("private key %s is not pem encoded", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse private key %s: %w", path, err)
+	}
+
+	switch typedKey := key.(type) {
+	case *rsa.PrivateKey:
+		return typedKey, nil
+	case *ecdsa.PrivateKey:
+		if typedKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("private key %s: only ec keys with curve P-256 are supported", path)
+		}
+		return typedKey, nil
+	default:
+		return nil, fmt.Errorf("private key %s: only rsa and ec keys are supported, got %T", path, key)
+	}
+}
+
+// newClientCredentialsTokenProvider creates a token provider which fetches tokens from a token endpoint with the
+// oauth client credentials grant. The client authenticates with a secret or a jwt signed with a private key.
+func newClientCredentialsTokenProvider(options map[string]any) (sarama.AccessTokenProvider, error) {
+
+	output.Debugf("using plugin=oauth-client-credentials")
+
+	tokenURL, err := stringOption(options, "tokenUrl", true)
+	if err != nil {
+		return nil, err
+	}
+	clientID, err := stringOption(options, "clientId", true)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := stringOption(options, "clientSecret", false)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyPath, err := stringOption(options, "privateKey", false)
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := stringOption(options, "keyId", false)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := stringOption(options, "audience", false)
+	if err != nil {
+		return nil, err
+	}
+	authMethod, err := stringOption(options, "authMethod", false)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := stringSliceOption(options, "scopes")
+	if err != nil {
+		return nil, err
+	}
+	extensions, err := stringMapOption(options, "extensions")
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &clientCredentialsTokenProvider{
+		client:     &http.Client{Timeout: 30 * time.Second},
+		tokenURL:   tokenURL,
+		clientID:   clientID,
+		keyID:      keyID,
+		scopes:     scopes,
+		audience:   audience,
+		extensions: extensions,
+		now:        time.Now,
+	}
+
+	switch authMethod {
+	case "", "client_secret_post":
+	case "client_secret_basic":
+		provider.basicAuth = true
+	default:
+		return nil, fmt.Errorf("option 'authMethod' must be client_secret_post or client_secret_basic, got %q", authMethod)
+	}
+
+	switch {
+	case clientSecret != "" && privateKeyPath != "":
+		return nil, errors.New("options 'clientSecret' and 'privateKey' cannot be used together")
+	case privateKeyPath != "":
+		resolvedPath, err := global.ResolvePath(privateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve path %q: %w", privateKeyPath, err)
+		}
+		if provider.privateKey, err = loadPrivateKey(resolvedPath); err != nil {
+			return nil, err
+		}
+	case clientSecret != "":
+		if provider.clientSecret, err = credential.ResolveReference(clientSecret); err != nil {
+			return nil, fmt.Errorf("failed to resolve option 'clientSecret': %w", err)
+		}
+	default:
+		return nil, errors.New("one of the options 'clientSecret' or 'privateKey' is required")
+	}
+
+	return provider, nil
+}
+
+// Option returns the value of an option. Keys are compared case-insensitive, because viper lowercases them.
+func Option(options map[string]any, name string) (any, bool) {
+	for key, value := range options {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func stringOption(options map[string]any, name string, required bool) (string, error) {
+	value, ok := Option(options, name)
+	if !ok || value == nil {
+		if required {
+			return "", fmt.Errorf("missing required option '%s'", name)
+		}
+		return "", nil
+	}
+	stringValue, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("option '%s' must be a string, got %T", name, value)
+	}
+	if required && stringValue == "" {
+		return "", fmt.Errorf("option '%s' can't be empty", name)
+	}
+	return stringValue, nil
+}
+
+func stringSliceOption(options map[string]any, name string) ([]string, error) {
+	value, ok := Option(options, name)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	switch typedValue := value.(type) {
+	case string:
+		return strings.Fields(typedValue), nil
+	case []string:
+		return typedValue, nil
+	case []any:
+		values := make([]string, len(typedValue))
+		for i, v := range typedValue {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("option '%s[%d]' must be a string, got %T", name, i, v)
+			}
+			values[i] = s
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("option '%s' must be a string array, got %T", name, value)
+	}
+}
+
+func stringMapOption(options map[string]any, name string) (map[string]string, error) {
+	value, ok := Option(options, name)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	mapValue, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("option '%s' must be a map, got %T", name, value)
+	}
+	values := make(map[string]string, len(mapValue))
+	for key, v := range mapValue {
+		if casedKey, ok := knownExtensions[strings.ToLower(key)]; ok {
+			key = casedKey
+		}
+		values[key] = fmt.Sprint(v)
+	}
+	return values, nil
+}
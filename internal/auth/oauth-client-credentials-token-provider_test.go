@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenEndpointStub struct {
+	server   *httptest.Server
+	requests []*http.Request
+	forms    []map[string]string
+}
+
+func newTokenEndpointStub(t *testing.T, expiresIn int) *tokenEndpointStub {
+	t.Helper()
+	stub := &tokenEndpointStub{}
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form := make(map[string]string)
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+		stub.requests = append(stub.requests, r)
+		stub.forms = append(stub.forms, form)
+
+		if form["grant_type"] != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", len(stub.requests)),
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+		})
+	}))
+	t.Cleanup(stub.server.Close)
+	return stub
+}
+
+func TestClientCredentialsTokenProvider_ClientSecret(t *testing.T) {
+	stub := newTokenEndpointStub(t, 300)
+	t.Setenv("KAFKACTL_TEST_CLIENT_SECRET", "my-secret")
+
+	provider, err := newClientCredentialsTokenProvider(map[string]any{
+		"tokenurl":     stub.server.URL,
+		"clientid":     "my-client",
+		"clientsecret": "env:KAFKACTL_TEST_CLIENT_SECRET",
+		"scopes":       []any{"kafka", "profile"},
+		"audience":     "my-cluster",
+		"extensions":   map[string]any{"logicalcluster": "lkc-123", "identitypoolid": "pool-abc"},
+	})
+	require.NoError(t, err)
+
+	token, err := provider.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.Token)
+	assert.Equal(t, map[string]string{"logicalCluster": "lkc-123", "identityPoolId": "pool-abc"}, token.Extensions)
+
+	require.Len(t, stub.forms, 1)
+	assert.Equal(t, "my-client", stub.forms[0]["client_id"])
+	assert.Equal(t, "my-secret", stub.forms[0]["client_secret"])
+	assert.Equal(t, "kafka profile", stub.forms[0]["scope"])
+	assert.Equal(t, "my-cluster", stub.forms[0]["audience"])
+}
+
+func TestClientCredentialsTokenProvider_BasicAuth(t *testing.T) {
+	stub := newTokenEndpointStub(t, 300)
+
+	provider, err := newClientCredentialsTokenProvider(map[string]any{
+		"tokenUrl":     stub.server.URL,
+		"clientId":     "my-client",
+		"clientSecret": "my-secret",
+		"authMethod":   "client_secret_basic",
+	})
+	require.NoError(t, err)
+
+	_, err = provider.Token()
+	require.NoError(t, err)
+
+	username, password, ok := stub.requests[0].BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "my-client", username)
+	assert.Equal(t, "my-secret", password)
+	assert.NotContains(t, stub.forms[0], "client_secret")
+}
+
+func TestClientCredentialsTokenProvider_CachesAndRefreshes(t *testing.T) {
+	stub := newTokenEndpointStub(t, 300)
+
+	provider, err := newClientCredentialsTokenProvider(map[string]any{
+		"tokenUrl":     stub.server.URL,
+		"clientId":     "my-client",
+		"clientSecret": "my-secret",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	provider.(*clientCredentialsTokenProvider).now = func() time.Time { return now }
+
+	token, err := provider.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.Token)
+
+	// cached until one minute before expiry
+	now = now.Add(239 * time.Second)
+	token, err = provider.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.Token)
+	assert.Len(t, stub.requests, 1)
+
+	now = now.Add(time.Second)
+	token, err = provider.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.Token)
+	assert.Len(t, stub.requests, 2)
+}
+
+func TestClientCredentialsTokenProvider_DefaultLifetime(t *testing.T) {
+	stub := newTokenEndpointStub(t, 0)
+
+	provider, err := newClientCredentialsTokenProvider(map[string]any{
+		"tokenUrl":     stub.server.URL,
+		"clientId":     "my-client",
+		"clientSecret": "my-secret",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	provider.(*clientCredentialsTokenProvider).now = func() time.Time { return now }
+
+	_, err = provider.Token()
+	require.NoError(t, err)
+
+	// without expires_in the token is assumed to be valid for five minutes
+	now = now.Add(3 * time.Minute)
+	_, err = provider.Token()
+	require.NoError(t, err)
+	assert.Len(t, stub.requests, 1)
+
+	now = now.Add(time.Minute)
+	token, err := provider.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.Token)
+}
+
+func TestClientCredentialsTokenProvider_PrivateKeyJWT(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	for name, key := range map[string]crypto.Signer{"rsa": rsaKey, "ec": ecKey} {
+		t.Run(name, func(t *testing.T) {
+			stub := newTokenEndpointStub(t, 300)
+
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			require.NoError(t, err)
+			keyPath := filepath.Join(t.TempDir(), "client.key")
+			require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+			provider, err := newClientCredentialsTokenProvider(map[string]any{
+				"tokenUrl":   stub.server.URL,
+				"clientId":   "my-client",
+				"privateKey": keyPath,
+				"keyId":      "key-1",
+			})
+			require.NoError(t, err)
+
+			_, err = provider.Token()
+			require.NoError(t, err)
+
+			form := stub.forms[0]
+			assert.Equal(t, clientAssertionType, form["client_assertion_type"])
+			assert.NotContains(t, form, "client_secret")
+
+			parts := strings.Split(form["client_assertion"], ".")
+			require.Len(t, parts, 3)
+
+			var header, claims map[string]any
+			decodeSegment(t, parts[0], &header)
+			decodeSegment(t, parts[1], &claims)
+			assert.Equal(t, "key-1", header["kid"])
+			assert.Equal(t, "my-client", claims["iss"])
+			assert.Equal(t, "my-client", claims["sub"])
+			assert.Equal(t, stub.server.URL, claims["aud"])
+
+			signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+			require.NoError(t, err)
+			digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+
+			switch typedKey := key.(type) {
+			case *rsa.PrivateKey:
+				assert.Equal(t, "RS256", header["alg"])
+				assert.NoError(t, rsa.VerifyPKCS1v15(&typedKey.PublicKey, crypto.SHA256, digest[:], signature))
+			case *ecdsa.PrivateKey:
+				assert.Equal(t, "ES256", header["alg"])
+				require.Len(t, signature, 64)
+				r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+				assert.True(t, ecdsa.Verify(&typedKey.PublicKey, digest[:], r, s))
+			}
+		})
+	}
+}
+
+func TestClientCredentialsTokenProvider_InvalidOptions(t *testing.T) {
+	testCases := []struct {
+		options map[string]any
+		wantErr string
+	}{
+		{map[string]any{"clientId": "c", "clientSecret": "s"}, "missing required option 'tokenUrl'"},
+		{map[string]any{"tokenUrl": "http://localhost", "clientSecret": "s"}, "missing required option 'clientId'"},
+		{map[string]any{"tokenUrl": "http://localhost", "clientId": "c"}, "one of the options 'clientSecret' or 'privateKey' is required"},
+		{map[string]any{"tokenUrl": "http://localhost", "clientId": "c", "clientSecret": "s", "privateKey": "k"}, "cannot be used together"},
+		{map[string]any{"tokenUrl": "http://localhost", "clientId": "c", "clientSecret": "s", "authMethod": "x"}, "option 'authMethod'"},
+	}
+
+	for _, tc := range testCases {
+		_, err := newClientCredentialsTokenProvider(tc.options)
+		assert.ErrorContains(t, err, tc.wantErr)
+	}
+}
+
+func TestClientCredentialsTokenProvider_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := newClientCredentialsTokenProvider(map[string]any{
+		"tokenUrl":     server.URL,
+		"clientId":     "my-client",
+		"clientSecret": "wrong",
+	})
+	require.NoError(t, err)
+
+	_, err = provider.Token()
+	assert.ErrorContains(t, err, "401")
+	assert.ErrorContains(t, err, "invalid_client")
+}
+
+func decodeSegment(t *testing.T, segment string, target any) {
+	t.Helper()
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(decoded, target))
+}
@@ -1,6 +1,9 @@
 package auth
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/IBM/sarama"
 	"github.com/deviceinsight/kafkactl/v5/internal/util"
 	"github.com/deviceinsight/kafkactl/v5/pkg/plugins/auth"
@@ -8,6 +11,12 @@ import (
 
 var loadedPlugins = make(map[string]auth.AccessTokenProvider)
 
+// clientCredentialsProviders are reused, so that clients of the same context share the cached token
+var (
+	clientCredentialsProviders = make(map[string]sarama.AccessTokenProvider)
+	clientCredentialsMutex     sync.Mutex
+)
+
 type pluginTokenProvider struct {
 	pluginDelegate auth.AccessTokenProvider
 }
@@ -17,9 +26,12 @@ func (p *pluginTokenProvider) Token() (*sarama.AccessToken, error) {
 	return &sarama.AccessToken{Token: token}, err
 }
 
-func LoadTokenProviderPlugin(pluginName string, options map[string]any, brokers []string) (sarama.AccessTokenProvider, error) {
-	if pluginName == "generic" {
+func LoadTokenProviderPlugin(contextName, pluginName string, options map[string]any, brokers []string) (sarama.AccessTokenProvider, error) {
+	switch pluginName {
+	case "generic":
 		return newGenericTokenProvider(options)
+	case "oauth-client-credentials":
+		return loadClientCredentialsTokenProvider(contextName, options)
 	}
 
 	loadedPlugin, ok := loadedPlugins[pluginName]
@@ -42,3 +54,25 @@ func LoadTokenProviderPlugin(pluginName string, options map[string]any, brokers
 
 	return &pluginTokenProvider{loadedPlugin}, nil
 }
+
+// loadClientCredentialsTokenProvider returns the cached provider of the context, token url and client id. The client
+// secret is not part of the cache key.
+func loadClientCredentialsTokenProvider(contextName string, options map[string]any) (sarama.AccessTokenProvider, error) {
+
+	tokenURL, _ := Option(options, "tokenUrl")
+	clientID, _ := Option(options, "clientId")
+	key := fmt.Sprintf("%s|%v|%v", contextName, tokenURL, clientID)
+
+	clientCredentialsMutex.Lock()
+	defer clientCredentialsMutex.Unlock()
+
+	if provider, ok := clientCredentialsProviders[key]; ok {
+		return provider, nil
+	}
+	provider, err := newClientCredentialsTokenProvider(options)
+	if err != nil {
+		return nil, err
+	}
+	clientCredentialsProviders[key] = provider
+	return provider, nil
+}
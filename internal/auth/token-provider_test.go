@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadClientCredentialsTokenProviderIsCachedPerContext(t *testing.T) {
+
+	options := map[string]any{"tokenurl": "https://idp.example.com/token", "clientid": "kafkactl", "clientsecret": "first"}
+
+	provider, err := LoadTokenProviderPlugin("cached-a", "oauth-client-credentials", options, nil)
+	require.NoError(t, err)
+
+	options["clientsecret"] = "second"
+	sameProvider, err := LoadTokenProviderPlugin("cached-a", "oauth-client-credentials", options, nil)
+	require.NoError(t, err)
+	assert.Same(t, provider, sameProvider)
+
+	otherProvider, err := LoadTokenProviderPlugin("cached-b", "oauth-client-credentials", options, nil)
+	require.NoError(t, err)
+	assert.NotSame(t, provider, otherProvider)
+
+	for key := range clientCredentialsProviders {
+		assert.NotContains(t, key, "first")
+		assert.NotContains(t, key, "second")
+	}
+}
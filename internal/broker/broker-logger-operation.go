@@ -0,0 +1,250 @@
+package broker
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/helpers"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+)
+
+var logLevels = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL", "OFF"}
+
+type GetBrokerLoggersFlags struct {
+	Filter       string
+	OutputFormat string
+}
+
+type AlterBrokerLoggerFlags struct {
+	RevertAfter time.Duration
+}
+
+type BrokerLogger struct {
+	Name  string
+	Level string
+}
+
+// GetBrokerLoggers prints the log levels of the loggers of a broker.
+func (operation *Operation) GetBrokerLoggers(id string, flags GetBrokerLoggersFlags) error {
+
+	var (
+		err     error
+		context internal.ClientContext
+		admin   sarama.ClusterAdmin
+		filter  glob.Glob
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if flags.Filter != "" {
+		if filter, err = glob.Compile(flags.Filter); err != nil {
+			return errors.Wrapf(err, "invalid filter: %s", flags.Filter)
+		}
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	loggers, err := readBrokerLoggers(admin, id)
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]BrokerLogger, 0, len(loggers))
+	for _, logger := range loggers {
+		if filter == nil || filter.Match(logger.Name) {
+			filtered = append(filtered, logger)
+		}
+	}
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(filtered, flags.OutputFormat)
+	}
+
+	tableWriter := output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("LOGGER", "LEVEL"); err != nil {
+		return err
+	}
+
+	for _, logger := range filtered {
+		if err := tableWriter.Write(logger.Name, logger.Level); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+// AlterBrokerLogger sets the log levels of loggers of a broker. With RevertAfter the previous levels are restored
+// after the given duration or when the command is interrupted.
+func (operation *Operation) AlterBrokerLogger(id string, loggerLevels []string, flags AlterBrokerLoggerFlags) error {
+
+	var (
+		err     error
+		context internal.ClientContext
+		admin   sarama.ClusterAdmin
+	)
+
+	levels, err := parseLoggerLevels(loggerLevels)
+	if err != nil {
+		return err
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	loggers, err := readBrokerLoggers(admin, id)
+	if err != nil {
+		return err
+	}
+
+	previousLevels := make(map[string]string)
+	for _, logger := range loggers {
+		if _, ok := levels[logger.Name]; ok {
+			previousLevels[logger.Name] = logger.Level
+		}
+	}
+
+	if err := setLoggerLevels(admin, id, levels); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(levels) {
+		output.Infof("logger %s of broker %s set to %s", name, id, levels[name])
+	}
+
+	if flags.RevertAfter <= 0 {
+		return nil
+	}
+
+	revert := make(map[string]string)
+	for name := range levels {
+		// loggers that did not exist before inherit the level of the root logger after the delete
+		revert[name] = previousLevels[name]
+	}
+
+	output.Infof("log levels will be reverted in %s (or on Ctrl+C)", flags.RevertAfter)
+	output.Infof("if kafkactl is killed, revert manually with: %s", revertCommand(context.Name, id, revert))
+
+	ctx := helpers.CreateTerminalContext()
+	select {
+	case <-time.After(flags.RevertAfter):
+	case <-ctx.Done():
+	}
+
+	if err := setLoggerLevels(admin, id, revert); err != nil {
+		return errors.Wrap(err, "failed to revert log levels")
+	}
+
+	for _, name := range sortedKeys(revert) {
+		level := revert[name]
+		if level == "" {
+			level = "default"
+		}
+		output.Infof("logger %s of broker %s reverted to %s", name, id, level)
+	}
+	return nil
+}
+
+func readBrokerLoggers(admin sarama.ClusterAdmin, id string) ([]BrokerLogger, error) {
+
+	if _, err := strconv.Atoi(id); err != nil {
+		return nil, errors.Errorf("invalid broker id: %s", id)
+	}
+
+	configs, err := internal.DescribeConfig(&admin, sarama.ConfigResource{Type: sarama.BrokerLoggerResource, Name: id})
+	if err != nil {
+		return nil, err
+	}
+
+	loggers := make([]BrokerLogger, 0, len(configs))
+	for _, config := range configs {
+		loggers = append(loggers, BrokerLogger{Name: config.Name, Level: config.Value})
+	}
+
+	sort.Slice(loggers, func(i, j int) bool { return loggers[i].Name < loggers[j].Name })
+	return loggers, nil
+}
+
+// setLoggerLevels sets the levels of the loggers. An empty level resets the logger to the level of the root logger.
+func setLoggerLevels(admin sarama.ClusterAdmin, id string, levels map[string]string) error {
+
+	entries := make(map[string]sarama.IncrementalAlterConfigsEntry)
+	for name, level := range levels {
+		if level == "" {
+			entries[name] = sarama.IncrementalAlterConfigsEntry{Operation: sarama.IncrementalAlterConfigsOperationDelete}
+		} else {
+			value := level
+			entries[name] = sarama.IncrementalAlterConfigsEntry{Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &value}
+		}
+	}
+
+	if err := admin.IncrementalAlterConfig(sarama.BrokerLoggerResource, id, entries, false); err != nil {
+		return errors.Wrapf(err, "could not alter loggers of broker '%s'", id)
+	}
+	return nil
+}
+
+func parseLoggerLevels(loggerLevels []string) (map[string]string, error) {
+
+	levels := make(map[string]string)
+
+	for _, loggerLevel := range loggerLevels {
+		name, level, found := strings.Cut(loggerLevel, "=")
+		if !found || name == "" {
+			return nil, errors.Errorf("logger level has to be in format LOGGER=LEVEL: %s", loggerLevel)
+		}
+
+		level = strings.ToUpper(level)
+		valid := level == ""
+		for _, logLevel := range logLevels {
+			valid = valid || level == logLevel
+		}
+
+		if !valid {
+			return nil, errors.Errorf("invalid log level %q for logger %s. One of: %s", level, name, strings.Join(logLevels, "|"))
+		}
+		levels[name] = level
+	}
+
+	return levels, nil
+}
+
+// revertCommand returns the command that restores the given levels.
+func revertCommand(contextName, id string, levels map[string]string) string {
+	command := []string{"kafkactl", "alter", "broker-logger", id}
+	for _, name := range sortedKeys(levels) {
+		command = append(command, name+"="+levels[name])
+	}
+	return strings.Join(append(command, "--context", contextName), " ")
+}
+
+func sortedKeys(levels map[string]string) []string {
+	keys := make([]string, 0, len(levels))
+	for key := range levels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
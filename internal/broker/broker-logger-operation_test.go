@@ -0,0 +1,35 @@
+package broker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLoggerLevels(t *testing.T) {
+
+	levels, err := parseLoggerLevels([]string{"kafka.server=debug", "kafka.controller=WARN", "kafka.log="})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"kafka.server": "DEBUG", "kafka.controller": "WARN", "kafka.log": ""}
+	if !reflect.DeepEqual(levels, expected) {
+		t.Fatalf("expected %v, got %v", expected, levels)
+	}
+
+	for _, invalid := range []string{"kafka.server", "=DEBUG", "kafka.server=VERBOSE"} {
+		if _, err := parseLoggerLevels([]string{invalid}); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestRevertCommand(t *testing.T) {
+
+	command := revertCommand("prod", "101", map[string]string{"kafka.server": "INFO", "kafka.log": ""})
+
+	expected := "kafkactl alter broker-logger 101 kafka.log= kafka.server=INFO --context prod"
+	if command != expected {
+		t.Fatalf("expected %q, got %q", expected, command)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/deviceinsight/kafkactl/v5/internal"
 	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ type Broker struct {
 	ID      int32             `json:",omitempty" yaml:",omitempty"`
 	Address string            `json:",omitempty" yaml:",omitempty"`
 	Configs []internal.Config `json:",omitempty" yaml:",omitempty"`
+	LogDirs []LogDir          `json:",omitempty" yaml:",omitempty"`
 }
 
 type GetBrokersFlags struct {
@@ -27,6 +29,7 @@ type GetBrokersFlags struct {
 
 type DescribeBrokerFlags struct {
 	AllConfigs   bool
+	LogDirs      bool
 	OutputFormat string
 }
 
@@ -297,6 +300,15 @@ func (operation *Operation) DescribeBroker(id string, flags DescribeBrokerFlags)
 		brokerInfo.Address = broker.Addr()
 	}
 
+	if flags.LogDirs {
+		if broker == nil {
+			return errors.New("log dirs can only be described for a broker id")
+		}
+		if brokerInfo.LogDirs, err = ReadLogDirs(admin, []int32{broker.ID()}); err != nil {
+			return err
+		}
+	}
+
 	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
 		return output.PrintObject(brokerInfo, flags.OutputFormat)
 	} else if flags.OutputFormat != "" && flags.OutputFormat != "wide" {
@@ -337,9 +349,57 @@ func (operation *Operation) DescribeBroker(id string, flags DescribeBrokerFlags)
 		return err
 	}
 
+	if flags.LogDirs {
+		output.PrintStrings("")
+		return printLogDirs(brokerInfo.LogDirs)
+	}
+
 	return nil
 }
 
+func printLogDirs(logDirs []LogDir) error {
+
+	tableWriter := output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("LOG_DIR", "SIZE", "USABLE", "TOTAL", "ERROR"); err != nil {
+		return err
+	}
+
+	replicas := make([]ReplicaSize, 0)
+
+	for _, logDir := range logDirs {
+		usable, total := "-", "-"
+		if logDir.TotalBytes > 0 {
+			usable, total = util.FormatSize(logDir.UsableBytes), util.FormatSize(logDir.TotalBytes)
+		}
+		if err := tableWriter.Write(logDir.Path, util.FormatSize(logDir.Size), usable, total, logDir.Error); err != nil {
+			return err
+		}
+		replicas = append(replicas, logDir.Replicas...)
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		return err
+	}
+
+	output.PrintStrings("")
+
+	SortReplicasBySize(replicas)
+
+	if err := tableWriter.WriteHeader("TOPIC", "PARTITION", "LOG_DIR", "SIZE", "OFFSET_LAG"); err != nil {
+		return err
+	}
+
+	for _, replica := range replicas {
+		if err := tableWriter.Write(replica.Topic, strconv.Itoa(int(replica.Partition)), replica.LogDir,
+			util.FormatSize(replica.Size), strconv.FormatInt(replica.OffsetLag, 10)); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
 func (operation *Operation) listBrokerIDs() ([]string, error) {
 
 	var (
@@ -0,0 +1,88 @@
+package broker
+
+import (
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+type LogDir struct {
+	Broker      int32
+	Path        string
+	Size        int64
+	TotalBytes  int64         `json:"totalBytes,omitempty" yaml:"totalBytes,omitempty"`
+	UsableBytes int64         `json:"usableBytes,omitempty" yaml:"usableBytes,omitempty"`
+	Error       string        `json:",omitempty" yaml:",omitempty"`
+	Replicas    []ReplicaSize `json:",omitempty" yaml:",omitempty"`
+}
+
+// ReplicaSize is the size of a partition replica in a log dir of a broker.
+type ReplicaSize struct {
+	Topic       string
+	Partition   int32
+	Broker      int32
+	LogDir      string `json:"logDir" yaml:"logDir"`
+	Size        int64
+	OffsetLag   int64 `json:"offsetLag" yaml:"offsetLag"`
+	IsTemporary bool  `json:"isTemporary,omitempty" yaml:"isTemporary,omitempty"`
+}
+
+// ReadLogDirs reads the log dirs of the given brokers. The replicas of each log dir are sorted by size.
+func ReadLogDirs(admin sarama.ClusterAdmin, brokerIDs []int32) ([]LogDir, error) {
+
+	logDirsByBroker, err := admin.DescribeLogDirs(brokerIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe log dirs")
+	}
+
+	logDirs := make([]LogDir, 0)
+
+	for brokerID, dirs := range logDirsByBroker {
+		for _, dir := range dirs {
+			logDir := LogDir{Broker: brokerID, Path: dir.Path, TotalBytes: dir.TotalBytes, UsableBytes: dir.UsableBytes}
+
+			if !errors.Is(dir.ErrorCode, sarama.ErrNoError) {
+				logDir.Error = dir.ErrorCode.Error()
+			}
+
+			for _, topic := range dir.Topics {
+				for _, partition := range topic.Partitions {
+					logDir.Replicas = append(logDir.Replicas, ReplicaSize{Topic: topic.Topic, Partition: partition.PartitionID,
+						Broker: brokerID, LogDir: dir.Path, Size: partition.Size, OffsetLag: partition.OffsetLag,
+						IsTemporary: partition.IsTemporary})
+					logDir.Size += partition.Size
+				}
+			}
+
+			SortReplicasBySize(logDir.Replicas)
+			logDirs = append(logDirs, logDir)
+		}
+	}
+
+	sort.Slice(logDirs, func(i, j int) bool {
+		if logDirs[i].Broker != logDirs[j].Broker {
+			return logDirs[i].Broker < logDirs[j].Broker
+		}
+		return logDirs[i].Path < logDirs[j].Path
+	})
+
+	return logDirs, nil
+}
+
+// SortReplicasBySize sorts replicas by size (largest first), then by topic, partition and broker.
+func SortReplicasBySize(replicas []ReplicaSize) {
+	sort.Slice(replicas, func(i, j int) bool {
+		a, b := replicas[i], replicas[j]
+		if a.Size != b.Size {
+			return a.Size > b.Size
+		}
+		if a.Topic != b.Topic {
+			return a.Topic < b.Topic
+		}
+		if a.Partition != b.Partition {
+			return a.Partition < b.Partition
+		}
+		return a.Broker < b.Broker
+	})
+}
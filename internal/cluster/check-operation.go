@@ -0,0 +1,212 @@
+package cluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/pkg/errors"
+)
+
+type CheckClusterFlags struct {
+	ExpectedBrokers []int32
+	Thresholds      Thresholds
+	OutputFormat    string
+}
+
+type Operation struct {
+}
+
+// CheckCluster prints a health report of the cluster and fails if a check exceeds its threshold.
+func (operation *Operation) CheckCluster(flags CheckClusterFlags) error {
+
+	var (
+		context internal.ClientContext
+		admin   sarama.ClusterAdmin
+		err     error
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	brokers, controller, err := admin.DescribeCluster()
+	if err != nil {
+		return errors.Wrap(err, "failed to describe cluster")
+	}
+
+	brokerIDs := make([]int32, 0, len(brokers))
+	for _, broker := range brokers {
+		brokerIDs = append(brokerIDs, broker.ID())
+	}
+
+	topics, err := admin.ListTopics()
+	if err != nil {
+		return errors.Wrap(err, "failed to list topics")
+	}
+
+	topicNames := make([]string, 0, len(topics))
+	for name := range topics {
+		topicNames = append(topicNames, name)
+	}
+
+	metadata, err := admin.DescribeTopics(topicNames)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe topics")
+	}
+
+	minISR, err := minInSyncReplicas(topics)
+	if err != nil {
+		return err
+	}
+
+	health := evaluateHealth(controller, brokerIDs, metadata, minISR, flags.ExpectedBrokers, flags.Thresholds)
+
+	if flags.OutputFormat != "" {
+		if err := output.PrintObject(health, flags.OutputFormat); err != nil {
+			return err
+		}
+	} else if err := printHealth(health); err != nil {
+		return err
+	}
+
+	if failed := health.FailedChecks(); len(failed) > 0 {
+		return errors.Errorf("cluster check failed: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// minInSyncReplicas reads min.insync.replicas from the topic configs, which ListTopics describes in a single
+// request. Only non-default configs are returned, so a missing config means the kafka default of 1.
+func minInSyncReplicas(topics map[string]sarama.TopicDetail) (map[string]int, error) {
+
+	minISR := make(map[string]int, len(topics))
+	for name, detail := range topics {
+		minISR[name] = 1
+		if value := detail.ConfigEntries["min.insync.replicas"]; value != nil {
+			parsed, err := strconv.Atoi(*value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid min.insync.replicas of topic '%s'", name)
+			}
+			minISR[name] = parsed
+		}
+	}
+	return minISR, nil
+}
+
+func printHealth(health Health) error {
+
+	output.PrintStrings(fmt.Sprintf("controller: %d", health.Controller))
+	output.PrintStrings(fmt.Sprintf("brokers: %s", formatIDs(health.Brokers)))
+	output.PrintStrings("")
+
+	tableWriter := output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("CHECK", "VALUE", "THRESHOLD", "STATUS"); err != nil {
+		return err
+	}
+
+	for _, check := range health.Checks {
+		threshold := "-"
+		if check.Threshold >= 0 {
+			threshold = strconv.Itoa(check.Threshold)
+		}
+		if err := tableWriter.Write(check.Name, strconv.Itoa(check.Value), threshold, check.Status); err != nil {
+			return err
+		}
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		return err
+	}
+
+	if len(health.MissingBrokers) > 0 {
+		output.PrintStrings("", fmt.Sprintf("missing brokers: %s", formatIDs(health.MissingBrokers)))
+	}
+
+	partitions := []struct {
+		title      string
+		partitions []PartitionHealth
+	}{
+		{"offline partitions", health.OfflinePartitions},
+		{"under-replicated partitions", health.UnderReplicatedPartitions},
+		{"partitions under min.insync.replicas", health.UnderMinISRPartitions},
+	}
+
+	for _, p := range partitions {
+		if len(p.partitions) == 0 {
+			continue
+		}
+		output.PrintStrings("", p.title+":")
+		if err := printPartitions(p.partitions); err != nil {
+			return err
+		}
+	}
+
+	output.PrintStrings("", "leaders:")
+
+	tableWriter = output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("BROKER", "PREFERRED_LEADER", "NOT_LEADER", "IMBALANCE"); err != nil {
+		return err
+	}
+
+	for _, broker := range health.LeaderImbalance {
+		if err := tableWriter.Write(strconv.Itoa(int(broker.Broker)), strconv.Itoa(broker.PreferredLeader),
+			strconv.Itoa(broker.NotLeader), fmt.Sprintf("%d%%", broker.Imbalance)); err != nil {
+			return err
+		}
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		return err
+	}
+
+	if len(health.ReplicationFactorOneTopics) > 0 {
+		output.PrintStrings("", "topics with replication factor 1:")
+		output.PrintStrings(health.ReplicationFactorOneTopics...)
+	}
+
+	return nil
+}
+
+func printPartitions(partitions []PartitionHealth) error {
+
+	tableWriter := output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("TOPIC", "PARTITION", "LEADER", "REPLICAS", "IN_SYNC_REPLICAS"); err != nil {
+		return err
+	}
+
+	for _, p := range partitions {
+		leader := strconv.Itoa(int(p.Leader))
+		if p.Leader < 0 {
+			leader = "none"
+		}
+		if err := tableWriter.Write(p.Topic, strconv.Itoa(int(p.Partition)), leader, formatIDs(p.Replicas), formatIDs(p.ISRs)); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func formatIDs(ids []int32) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(int(id)))
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,30 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func TestMinInSyncReplicas(t *testing.T) {
+
+	two := "2"
+	invalid := "two"
+
+	minISR, err := minInSyncReplicas(map[string]sarama.TopicDetail{
+		"a": {ConfigEntries: map[string]*string{"min.insync.replicas": &two}},
+		"b": {},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if minISR["a"] != 2 || minISR["b"] != 1 {
+		t.Fatalf("unexpected min.insync.replicas: %v", minISR)
+	}
+
+	if _, err = minInSyncReplicas(map[string]sarama.TopicDetail{
+		"a": {ConfigEntries: map[string]*string{"min.insync.replicas": &invalid}},
+	}); err == nil {
+		t.Fatal("expected error for invalid min.insync.replicas")
+	}
+}
@@ -0,0 +1,192 @@
+package cluster
+
+import (
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+)
+
+const (
+	statusOK     = "ok"
+	statusFailed = "failed"
+	statusInfo   = "info"
+)
+
+// Thresholds are the maximum values of the checks that are accepted. A negative threshold disables the check.
+type Thresholds struct {
+	UnderReplicated      int
+	Offline              int
+	UnderMinISR          int
+	LeaderImbalance      int
+	MissingBrokers       int
+	ReplicationFactorOne int
+}
+
+type Health struct {
+	Controller                 int32
+	Brokers                    []int32           `yaml:",flow"`
+	MissingBrokers             []int32           `json:"missingBrokers" yaml:"missingBrokers,flow"`
+	OfflinePartitions          []PartitionHealth `json:"offlinePartitions" yaml:"offlinePartitions"`
+	UnderReplicatedPartitions  []PartitionHealth `json:"underReplicatedPartitions" yaml:"underReplicatedPartitions"`
+	UnderMinISRPartitions      []PartitionHealth `json:"underMinIsrPartitions" yaml:"underMinIsrPartitions"`
+	LeaderImbalance            []BrokerLeaders   `json:"leaderImbalance" yaml:"leaderImbalance"`
+	ReplicationFactorOneTopics []string          `json:"replicationFactorOneTopics" yaml:"replicationFactorOneTopics"`
+	Checks                     []Check
+}
+
+type PartitionHealth struct {
+	Topic     string
+	Partition int32
+	Leader    int32
+	Replicas  []int32 `yaml:",flow"`
+	ISRs      []int32 `json:"inSyncReplicas" yaml:"inSyncReplicas,flow"`
+	MinISR    int     `json:"minInSyncReplicas,omitempty" yaml:"minInSyncReplicas,omitempty"`
+}
+
+// BrokerLeaders counts the partitions a broker is the preferred leader of and how many of them it does not lead.
+type BrokerLeaders struct {
+	Broker          int32
+	PreferredLeader int `json:"preferredLeader" yaml:"preferredLeader"`
+	NotLeader       int `json:"notLeader" yaml:"notLeader"`
+	Imbalance       int `json:"imbalancePercent" yaml:"imbalancePercent"`
+}
+
+type Check struct {
+	Name      string
+	Value     int
+	Threshold int
+	Status    string
+}
+
+// evaluateHealth checks the topic metadata. minISR contains the min.insync.replicas per topic,
+// expectedBrokers are brokers that have to be part of the metadata in addition to all brokers referenced by replicas.
+func evaluateHealth(controller int32, brokers []int32, metadata []*sarama.TopicMetadata, minISR map[string]int,
+	expectedBrokers []int32, thresholds Thresholds) Health {
+
+	health := Health{
+		Controller:                 controller,
+		Brokers:                    sortedIDs(brokers),
+		MissingBrokers:             make([]int32, 0),
+		OfflinePartitions:          make([]PartitionHealth, 0),
+		UnderReplicatedPartitions:  make([]PartitionHealth, 0),
+		UnderMinISRPartitions:      make([]PartitionHealth, 0),
+		LeaderImbalance:            make([]BrokerLeaders, 0),
+		ReplicationFactorOneTopics: make([]string, 0),
+	}
+
+	missing := make(map[int32]bool)
+	for _, id := range expectedBrokers {
+		if !util.ContainsInt32(brokers, id) {
+			missing[id] = true
+		}
+	}
+
+	leaders := make(map[int32]*BrokerLeaders)
+	for _, id := range brokers {
+		leaders[id] = &BrokerLeaders{Broker: id}
+	}
+
+	sort.Slice(metadata, func(i, j int) bool { return metadata[i].Name < metadata[j].Name })
+
+	for _, topic := range metadata {
+		replicationFactorOne := len(topic.Partitions) > 0
+
+		sort.Slice(topic.Partitions, func(i, j int) bool { return topic.Partitions[i].ID < topic.Partitions[j].ID })
+
+		for _, p := range topic.Partitions {
+			partition := PartitionHealth{Topic: topic.Name, Partition: p.ID, Leader: p.Leader, Replicas: p.Replicas, ISRs: p.Isr}
+
+			for _, replica := range p.Replicas {
+				if !util.ContainsInt32(brokers, replica) {
+					missing[replica] = true
+				}
+			}
+
+			if len(p.Replicas) > 1 {
+				replicationFactorOne = false
+			}
+
+			if p.Leader < 0 {
+				health.OfflinePartitions = append(health.OfflinePartitions, partition)
+			}
+
+			if len(p.Isr) < len(p.Replicas) {
+				health.UnderReplicatedPartitions = append(health.UnderReplicatedPartitions, partition)
+			}
+
+			if min, ok := minISR[topic.Name]; ok && len(p.Isr) < min {
+				partition.MinISR = min
+				health.UnderMinISRPartitions = append(health.UnderMinISRPartitions, partition)
+			}
+
+			if len(p.Replicas) > 0 {
+				if preferred, ok := leaders[p.Replicas[0]]; ok {
+					preferred.PreferredLeader++
+					if p.Leader != p.Replicas[0] {
+						preferred.NotLeader++
+					}
+				}
+			}
+		}
+
+		if replicationFactorOne {
+			health.ReplicationFactorOneTopics = append(health.ReplicationFactorOneTopics, topic.Name)
+		}
+	}
+
+	for id := range missing {
+		health.MissingBrokers = append(health.MissingBrokers, id)
+	}
+	health.MissingBrokers = sortedIDs(health.MissingBrokers)
+
+	maxImbalance := 0
+	for _, id := range health.Brokers {
+		broker := leaders[id]
+		if broker.PreferredLeader > 0 {
+			broker.Imbalance = broker.NotLeader * 100 / broker.PreferredLeader
+		}
+		if broker.Imbalance > maxImbalance {
+			maxImbalance = broker.Imbalance
+		}
+		health.LeaderImbalance = append(health.LeaderImbalance, *broker)
+	}
+
+	health.Checks = []Check{
+		newCheck("missing brokers", len(health.MissingBrokers), thresholds.MissingBrokers),
+		newCheck("offline partitions", len(health.OfflinePartitions), thresholds.Offline),
+		newCheck("under-replicated partitions", len(health.UnderReplicatedPartitions), thresholds.UnderReplicated),
+		newCheck("partitions under min.insync.replicas", len(health.UnderMinISRPartitions), thresholds.UnderMinISR),
+		newCheck("leader imbalance (%)", maxImbalance, thresholds.LeaderImbalance),
+		newCheck("topics with replication factor 1", len(health.ReplicationFactorOneTopics), thresholds.ReplicationFactorOne),
+	}
+
+	return health
+}
+
+func newCheck(name string, value, threshold int) Check {
+	check := Check{Name: name, Value: value, Threshold: threshold, Status: statusOK}
+	if threshold < 0 {
+		check.Status = statusInfo
+	} else if value > threshold {
+		check.Status = statusFailed
+	}
+	return check
+}
+
+// FailedChecks returns the names of all checks that exceed their threshold.
+func (health Health) FailedChecks() []string {
+	failed := make([]string, 0)
+	for _, check := range health.Checks {
+		if check.Status == statusFailed {
+			failed = append(failed, check.Name)
+		}
+	}
+	return failed
+}
+
+func sortedIDs(ids []int32) []int32 {
+	sorted := append([]int32{}, ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
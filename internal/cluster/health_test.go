@@ -0,0 +1,80 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func TestEvaluateHealth(t *testing.T) {
+
+	metadata := []*sarama.TopicMetadata{
+		{Name: "a", Partitions: []*sarama.PartitionMetadata{
+			{ID: 0, Leader: 1, Replicas: []int32{1, 2, 3}, Isr: []int32{1, 2, 3}},
+			{ID: 1, Leader: 3, Replicas: []int32{2, 3, 1}, Isr: []int32{3, 1}},
+			{ID: 2, Leader: -1, Replicas: []int32{4, 1, 2}, Isr: []int32{}},
+		}},
+		{Name: "b", Partitions: []*sarama.PartitionMetadata{
+			{ID: 0, Leader: 1, Replicas: []int32{1}, Isr: []int32{1}},
+		}},
+	}
+
+	thresholds := Thresholds{UnderReplicated: 0, Offline: 0, UnderMinISR: 0, LeaderImbalance: 10, MissingBrokers: 0, ReplicationFactorOne: -1}
+
+	health := evaluateHealth(1, []int32{3, 1, 2}, metadata, map[string]int{"a": 2, "b": 1}, []int32{5}, thresholds)
+
+	if health.Controller != 1 || len(health.Brokers) != 3 || health.Brokers[0] != 1 {
+		t.Fatalf("unexpected controller or brokers: %d %v", health.Controller, health.Brokers)
+	}
+	if len(health.MissingBrokers) != 2 || health.MissingBrokers[0] != 4 || health.MissingBrokers[1] != 5 {
+		t.Fatalf("unexpected missing brokers: %v", health.MissingBrokers)
+	}
+	if len(health.OfflinePartitions) != 1 || health.OfflinePartitions[0].Partition != 2 {
+		t.Fatalf("unexpected offline partitions: %v", health.OfflinePartitions)
+	}
+	if len(health.UnderReplicatedPartitions) != 2 {
+		t.Fatalf("unexpected under-replicated partitions: %v", health.UnderReplicatedPartitions)
+	}
+	if len(health.UnderMinISRPartitions) != 1 || health.UnderMinISRPartitions[0].MinISR != 2 {
+		t.Fatalf("unexpected partitions under min.insync.replicas: %v", health.UnderMinISRPartitions)
+	}
+	if len(health.ReplicationFactorOneTopics) != 1 || health.ReplicationFactorOneTopics[0] != "b" {
+		t.Fatalf("unexpected topics with replication factor 1: %v", health.ReplicationFactorOneTopics)
+	}
+
+	// broker 2 is the preferred leader of a-1 but does not lead it
+	for _, broker := range health.LeaderImbalance {
+		if broker.Broker == 2 && (broker.PreferredLeader != 1 || broker.Imbalance != 100) {
+			t.Fatalf("unexpected leader imbalance of broker 2: %v", broker)
+		}
+	}
+
+	failed := health.FailedChecks()
+	expected := []string{"missing brokers", "offline partitions", "under-replicated partitions",
+		"partitions under min.insync.replicas", "leader imbalance (%)"}
+
+	if len(failed) != len(expected) {
+		t.Fatalf("expected failed checks %v, got %v", expected, failed)
+	}
+	for i := range expected {
+		if failed[i] != expected[i] {
+			t.Fatalf("expected failed checks %v, got %v", expected, failed)
+		}
+	}
+}
+
+func TestEvaluateHealthOfHealthyCluster(t *testing.T) {
+
+	metadata := []*sarama.TopicMetadata{
+		{Name: "a", Partitions: []*sarama.PartitionMetadata{
+			{ID: 0, Leader: 1, Replicas: []int32{1, 2}, Isr: []int32{1, 2}},
+			{ID: 1, Leader: 2, Replicas: []int32{2, 1}, Isr: []int32{2, 1}},
+		}},
+	}
+
+	health := evaluateHealth(2, []int32{1, 2}, metadata, map[string]int{"a": 2}, nil, Thresholds{})
+
+	if failed := health.FailedChecks(); len(failed) > 0 {
+		t.Fatalf("expected no failed checks, got %v", failed)
+	}
+}
@@ -31,6 +31,16 @@ type TokenProvider struct {
 	Options    map[string]any
 }
 
+type KerberosConfig struct {
+	AuthType        string
+	KeytabPath      string
+	CCachePath      string
+	ConfigPath      string
+	Realm           string
+	ServiceName     string
+	DisablePAFXFAST bool
+}
+
 type SaslConfig struct {
 	Enabled       bool
 	Username      string
@@ -38,6 +48,9 @@ type SaslConfig struct {
 	Mechanism     string
 	TokenProvider TokenProvider
 	Version       string
+	TokenAuth     bool
+	AWS           auth.AWSConfig
+	Kerberos      KerberosConfig
 }
 
 type SchemaRegistryConfig struct {
@@ -97,6 +110,7 @@ type K8sConfig struct {
 	Image           string
 	ImagePullSecret string
 	TLSSecret       string
+	KeytabSecret    string
 	SaslSecret      K8sSaslSecretConfig
 	ServiceAccount  string
 	AsUser          string
@@ -135,6 +149,11 @@ type ClientContext struct {
 	SchemaRegistry SchemaRegistryConfig
 	Producer       ProducerConfig
 	Consumer       ConsumerConfig
+	Protection     ProtectionConfig
+
+	// confirmed remembers that destructive operations have been confirmed, so that operations on
+	// multiple resources only ask once.
+	confirmed bool
 }
 
 type Config struct {
@@ -228,20 +247,58 @@ func CreateClientContext() (ClientContext, error) {
 	context.Producer.ValueSerializer = viper.GetString("contexts." + context.Name + ".producer.valueSerializer")
 	context.Producer.KeySerializer = viper.GetString("contexts." + context.Name + ".producer.keySerializer")
 	context.Consumer.IsolationLevel = viper.GetString("contexts." + context.Name + ".consumer.isolationLevel")
+	context.Protection.ReadOnly = viper.GetBool("contexts." + context.Name + ".protection.readOnly")
+	context.Protection.ProtectedTopics = viper.GetStringSlice("contexts." + context.Name + ".protection.protectedTopics")
+	context.Protection.RequireConfirmation = viper.GetBool("contexts." + context.Name + ".protection.requireConfirmation")
 	context.Sasl.Enabled = viper.GetBool("contexts." + context.Name + ".sasl.enabled")
 	context.Sasl.Username = viper.GetString("contexts." + context.Name + ".sasl.username")
 	context.Sasl.Mechanism = viper.GetString("contexts." + context.Name + ".sasl.mechanism")
 	context.Sasl.Version = viper.GetString("contexts." + context.Name + ".sasl.version")
+	context.Sasl.TokenAuth = viper.GetBool("contexts." + context.Name + ".sasl.tokenAuth")
 	context.Sasl.TokenProvider.PluginName = viper.GetString("contexts." + context.Name + ".sasl.tokenProvider.plugin")
 	context.Sasl.TokenProvider.Options = viper.GetStringMap("contexts." + context.Name + ".sasl.tokenProvider.options")
+	context.Sasl.AWS.Region = viper.GetString("contexts." + context.Name + ".sasl.aws.region")
+	context.Sasl.AWS.Profile = viper.GetString("contexts." + context.Name + ".sasl.aws.profile")
+	context.Sasl.AWS.RoleArn = viper.GetString("contexts." + context.Name + ".sasl.aws.roleArn")
+	context.Sasl.AWS.RoleSessionName = viper.GetString("contexts." + context.Name + ".sasl.aws.roleSessionName")
+	context.Sasl.Kerberos.AuthType = viper.GetString("contexts." + context.Name + ".sasl.kerberos.authType")
+	context.Sasl.Kerberos.Realm = viper.GetString("contexts." + context.Name + ".sasl.kerberos.realm")
+	context.Sasl.Kerberos.ServiceName = viper.GetString("contexts." + context.Name + ".sasl.kerberos.serviceName")
+	context.Sasl.Kerberos.DisablePAFXFAST = viper.GetBool("contexts." + context.Name + ".sasl.kerberos.disablePAFXFAST")
+	if context.Sasl.Kerberos.KeytabPath, err = resolvePath("contexts." + context.Name + ".sasl.kerberos.keytabPath"); err != nil {
+		return context, err
+	}
+	if context.Sasl.Kerberos.CCachePath, err = resolvePath("contexts." + context.Name + ".sasl.kerberos.ccachePath"); err != nil {
+		return context, err
+	}
+	if context.Sasl.Kerberos.ConfigPath, err = resolvePath("contexts." + context.Name + ".sasl.kerberos.configPath"); err != nil {
+		return context, err
+	}
 
-	if context.Sasl.Enabled && context.Sasl.Username != "" {
+	// kerberos authentication with keytab or credential cache does not need a password
+	requiresPassword := context.Sasl.Mechanism != "gssapi" || strings.EqualFold(context.Sasl.Kerberos.AuthType, "password")
+
+	if context.Sasl.Enabled && context.Sasl.Username != "" && requiresPassword {
 		context.Sasl.Password, err = resolvePassword(credentials, context.Name, "sasl.password", "SASL Password")
 		if err != nil {
 			return context, err
 		}
 	}
 
+	// the client secret of the oauth-client-credentials token provider can be stored in the keyring or prompted
+	_, hasClientSecret := auth.Option(context.Sasl.TokenProvider.Options, "clientSecret")
+	_, hasPrivateKey := auth.Option(context.Sasl.TokenProvider.Options, "privateKey")
+	if context.Sasl.Enabled && context.Sasl.TokenProvider.PluginName == "oauth-client-credentials" && !hasClientSecret && !hasPrivateKey {
+		clientSecret, err := resolvePassword(credentials, context.Name, "sasl.tokenProvider.options.clientSecret", "OAuth Client Secret")
+		if err != nil {
+			return context, err
+		}
+		if context.Sasl.TokenProvider.Options == nil {
+			context.Sasl.TokenProvider.Options = make(map[string]any)
+		}
+		context.Sasl.TokenProvider.Options["clientsecret"] = clientSecret
+	}
+
 	viper.SetDefault("contexts."+context.Name+".kubernetes.binary", "kubectl")
 	context.Kubernetes.Enabled = IsKubernetesEnabled()
 
@@ -258,6 +315,7 @@ func CreateClientContext() (ClientContext, error) {
 	context.Kubernetes.Image = viper.GetString("contexts." + context.Name + ".kubernetes.image")
 	context.Kubernetes.ImagePullSecret = viper.GetString("contexts." + context.Name + ".kubernetes.imagePullSecret")
 	context.Kubernetes.TLSSecret = viper.GetString("contexts." + context.Name + ".kubernetes.tlsSecret")
+	context.Kubernetes.KeytabSecret = viper.GetString("contexts." + context.Name + ".kubernetes.keytabSecret")
 	context.Kubernetes.SaslSecret.Name = viper.GetString("contexts." + context.Name + ".kubernetes.saslSecret.name")
 	context.Kubernetes.SaslSecret.Create = viper.GetBool("contexts." + context.Name + ".kubernetes.saslSecret.create")
 	context.Kubernetes.ServiceAccount = viper.GetString("contexts." + context.Name + ".kubernetes.serviceAccount")
@@ -303,14 +361,48 @@ func newCredentialResolver() credential.Resolver {
 
 func resolvePassword(credentials credential.Resolver, contextName, configKey, promptLabel string) (string, error) {
 	if viper.IsSet("contexts." + contextName + "." + configKey) {
-		return viper.GetString("contexts." + contextName + "." + configKey), nil
+		return resolveReference(contextName, configKey)
 	}
 	return credentials.ResolvePassword(fmt.Sprintf("%s.%s", contextName, configKey), promptLabel)
 }
 
+// UpdateSaslPassword stores a new sasl password of the context where the current password was resolved from.
+// It returns a description of the location or an empty string if the password is not stored.
+func UpdateSaslPassword(context *ClientContext, password string) (string, error) {
+
+	if viper.IsSet("contexts." + context.Name + ".sasl.password") {
+		if reference := viper.GetString("contexts." + context.Name + ".sasl.password"); credential.IsReference(reference) {
+			return "", errors.Errorf("the password is resolved from %s and has to be updated there", reference)
+		}
+		configFile, err := global.UpdateContextConfig(context.Name, "sasl.password", password)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to update password in config file")
+		}
+		return "config file " + configFile, nil
+	}
+
+	if viper.IsSet("keyring.enabled") && !viper.GetBool("keyring.enabled") {
+		return "", nil
+	}
+
+	if err := credential.StorePassword(fmt.Sprintf("%s.%s", context.Name, "sasl.password"), password); err != nil {
+		return "", err
+	}
+	return "OS keyring", nil
+}
+
+// resolveReference resolves configured values that reference a secret, e.g. vault:secret/kafka#password
+func resolveReference(contextName, configKey string) (string, error) {
+	value, err := credential.ResolveReference(viper.GetString("contexts." + contextName + "." + configKey))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to resolve contexts.%s.%s", contextName, configKey)
+	}
+	return value, nil
+}
+
 func resolvePassphrase(credentials credential.Resolver, contextName, certKeyPath, configKey, promptLabel string) (string, error) {
 	if viper.IsSet("contexts." + contextName + "." + configKey) {
-		return viper.GetString("contexts." + contextName + "." + configKey), nil
+		return resolveReference(contextName, configKey)
 	}
 	return credentials.ResolveTLSPassphrase(certKeyPath, fmt.Sprintf("%s.%s", contextName, configKey), promptLabel)
 }
@@ -325,10 +417,15 @@ func CreateClient(context *ClientContext) (sarama.Client, error) {
 
 func CreateClusterAdmin(context *ClientContext) (sarama.ClusterAdmin, error) {
 	config, err := CreateClientConfig(context)
-	if err == nil {
-		return sarama.NewClusterAdmin(context.Brokers, config)
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	admin, err := sarama.NewClusterAdmin(context.Brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	// all modifications via the cluster admin are checked against the protection config of the context
+	return &protectedClusterAdmin{ClusterAdmin: admin, context: context}, nil
 }
 
 func CreateClientConfig(context *ClientContext) (*sarama.Config, error) {
@@ -369,19 +466,50 @@ func CreateClientConfig(context *ClientContext) (*sarama.Config, error) {
 		if strings.EqualFold(context.Sasl.Version, "v1") {
 			config.Net.SASL.Version = sarama.SASLHandshakeV1
 		}
+		if context.Sasl.TokenAuth && !strings.HasPrefix(context.Sasl.Mechanism, "scram-") {
+			return nil, errors.Errorf("sasl tokenAuth requires a scram mechanism: %s", context.Sasl.Mechanism)
+		}
 		switch context.Sasl.Mechanism {
 		case "scram-sha512":
 			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
 			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				if context.Sasl.TokenAuth {
+					return &helpers.SCRAMTokenClient{HashGeneratorFcn: helpers.SHA512}
+				}
 				return &helpers.XDGSCRAMClient{HashGeneratorFcn: helpers.SHA512}
 			}
 		case "scram-sha256":
 			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
 			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				if context.Sasl.TokenAuth {
+					return &helpers.SCRAMTokenClient{HashGeneratorFcn: helpers.SHA256}
+				}
 				return &helpers.XDGSCRAMClient{HashGeneratorFcn: helpers.SHA256}
 			}
 		case "oauth":
 			config.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+			tokenProvider, err := auth.LoadTokenProviderPlugin(context.Name, context.Sasl.TokenProvider.PluginName, context.Sasl.TokenProvider.Options, context.Brokers)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load tokenProvider")
+			}
+			config.Net.SASL.TokenProvider = tokenProvider
+		case "aws-msk-iam":
+			if !context.TLS.Enabled {
+				output.Warnf("sasl mechanism aws-msk-iam requires tls, set contexts.%s.tls.enabled", context.Name)
+			}
+			config.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+			tokenProvider, err := auth.NewAWSMskIamTokenProvider(context.Sasl.AWS)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create aws-msk-iam token provider")
+			}
+			config.Net.SASL.TokenProvider = tokenProvider
+		case "gssapi":
+			gssapiConfig, err := createGSSAPIConfig(context.Sasl)
+			if err != nil {
+				return nil, err
+			}
+			config.Net.SASL.Mechanism = sarama.SASLTypeGSSAPI
+			config.Net.SASL.GSSAPI = gssapiConfig
 		case "plaintext":
 			fallthrough
 		case "":
@@ -391,15 +519,72 @@ func CreateClientConfig(context *ClientContext) (*sarama.Config, error) {
 		}
 	}
 
-	if config.Net.SASL.Mechanism == sarama.SASLTypeOAuth {
-		tokenProvider, err := auth.LoadTokenProviderPlugin(context.Sasl.TokenProvider.PluginName, context.Sasl.TokenProvider.Options, context.Brokers)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to load tokenProvider")
+	return config, nil
+}
+
+// createGSSAPIConfig creates the kerberos config. If no authType is configured, a keytab is used if
+// configured and the credential cache otherwise.
+func createGSSAPIConfig(sasl SaslConfig) (sarama.GSSAPIConfig, error) {
+
+	kerberos := sasl.Kerberos
+
+	gssapiConfig := sarama.GSSAPIConfig{
+		KeyTabPath:         kerberos.KeytabPath,
+		CCachePath:         kerberos.CCachePath,
+		KerberosConfigPath: kerberos.ConfigPath,
+		ServiceName:        kerberos.ServiceName,
+		Username:           sasl.Username,
+		Password:           sasl.Password,
+		Realm:              kerberos.Realm,
+		DisablePAFXFAST:    kerberos.DisablePAFXFAST,
+	}
+
+	authType := strings.ToLower(kerberos.AuthType)
+	if authType == "" {
+		if kerberos.KeytabPath != "" {
+			authType = "keytab"
+		} else {
+			authType = "ccache"
 		}
-		config.Net.SASL.TokenProvider = tokenProvider
 	}
 
-	return config, nil
+	switch authType {
+	case "keytab":
+		gssapiConfig.AuthType = sarama.KRB5_KEYTAB_AUTH
+	case "ccache":
+		gssapiConfig.AuthType = sarama.KRB5_CCACHE_AUTH
+		if gssapiConfig.CCachePath == "" {
+			gssapiConfig.CCachePath = defaultCCachePath()
+		}
+	case "password":
+		gssapiConfig.AuthType = sarama.KRB5_USER_AUTH
+	default:
+		return gssapiConfig, errors.Errorf("unknown kerberos authType: %s", kerberos.AuthType)
+	}
+
+	if gssapiConfig.ServiceName == "" {
+		gssapiConfig.ServiceName = "kafka"
+	}
+
+	if gssapiConfig.KerberosConfigPath == "" {
+		if gssapiConfig.KerberosConfigPath = os.Getenv("KRB5_CONFIG"); gssapiConfig.KerberosConfigPath == "" {
+			gssapiConfig.KerberosConfigPath = "/etc/krb5.conf"
+		}
+	}
+
+	if gssapiConfig.Username == "" || gssapiConfig.Realm == "" {
+		return gssapiConfig, errors.New("sasl.username and sasl.kerberos.realm are required for gssapi")
+	}
+
+	return gssapiConfig, nil
+}
+
+// defaultCCachePath returns the credential cache like kinit does: KRB5CCNAME or /tmp/krb5cc_<uid>
+func defaultCCachePath() string {
+	if ccache := os.Getenv("KRB5CCNAME"); ccache != "" {
+		return strings.TrimPrefix(ccache, "FILE:")
+	}
+	return fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
 }
 
 func GetClientID(context *ClientContext, defaultPrefix string) string {
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/credential"
+	"github.com/spf13/viper"
 )
 
 func TestListConfigsFromEntries(t *testing.T) {
@@ -113,3 +115,74 @@ func TestSanitizeUsername(t *testing.T) {
 		})
 	}
 }
+
+func TestResolvePasswordResolvesReferences(t *testing.T) {
+
+	t.Setenv("KAFKACTL_TEST_SASL_PASSWORD", "env-secret")
+
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("contexts.test.sasl.password", "env:KAFKACTL_TEST_SASL_PASSWORD")
+	viper.Set("contexts.test.schemaRegistry.password", "plain-secret")
+
+	password, err := resolvePassword(credential.NewPromptCredentialResolver(), "test", "sasl.password", "SASL Password")
+	if err != nil || password != "env-secret" {
+		t.Fatalf("expected env-secret, got %q (%v)", password, err)
+	}
+
+	password, err = resolvePassword(credential.NewPromptCredentialResolver(), "test", "schemaRegistry.password", "Schema Registry Password")
+	if err != nil || password != "plain-secret" {
+		t.Fatalf("expected plain-secret, got %q (%v)", password, err)
+	}
+
+	viper.Set("contexts.test.sasl.password", "env:KAFKACTL_TEST_UNDEFINED")
+	if _, err = resolvePassword(credential.NewPromptCredentialResolver(), "test", "sasl.password", "SASL Password"); err == nil {
+		t.Fatal("expected error for unresolvable reference")
+	}
+}
+
+func TestCreateGSSAPIConfig(t *testing.T) {
+
+	t.Setenv("KRB5_CONFIG", "")
+	t.Setenv("KRB5CCNAME", "FILE:/tmp/krb5cc_test")
+
+	sasl := SaslConfig{Username: "kafka-client", Kerberos: KerberosConfig{Realm: "EXAMPLE.COM", KeytabPath: "/etc/kafka.keytab"}}
+
+	gssapiConfig, err := createGSSAPIConfig(sasl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gssapiConfig.AuthType != sarama.KRB5_KEYTAB_AUTH || gssapiConfig.KeyTabPath != "/etc/kafka.keytab" {
+		t.Fatalf("expected keytab auth, got %+v", gssapiConfig)
+	}
+	if gssapiConfig.ServiceName != "kafka" || gssapiConfig.KerberosConfigPath != "/etc/krb5.conf" {
+		t.Fatalf("expected defaults for serviceName and configPath, got %+v", gssapiConfig)
+	}
+
+	sasl.Kerberos.KeytabPath = ""
+	if gssapiConfig, err = createGSSAPIConfig(sasl); err != nil {
+		t.Fatal(err)
+	}
+	if gssapiConfig.AuthType != sarama.KRB5_CCACHE_AUTH || gssapiConfig.CCachePath != "/tmp/krb5cc_test" {
+		t.Fatalf("expected ccache auth with cache from KRB5CCNAME, got %+v", gssapiConfig)
+	}
+
+	sasl.Kerberos.AuthType = "password"
+	sasl.Password = "secret"
+	if gssapiConfig, err = createGSSAPIConfig(sasl); err != nil {
+		t.Fatal(err)
+	}
+	if gssapiConfig.AuthType != sarama.KRB5_USER_AUTH || gssapiConfig.Password != "secret" {
+		t.Fatalf("expected password auth, got %+v", gssapiConfig)
+	}
+
+	sasl.Kerberos.AuthType = "unknown"
+	if _, err = createGSSAPIConfig(sasl); err == nil {
+		t.Fatal("expected error for unknown authType")
+	}
+
+	sasl.Kerberos = KerberosConfig{}
+	if _, err = createGSSAPIConfig(sasl); err == nil {
+		t.Fatal("expected error for missing realm")
+	}
+}
@@ -0,0 +1,463 @@
+package consume
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/IBM/sarama"
+	"github.com/linkedin/goavro/v2"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+)
+
+const fileSinkPrefix = "file://"
+
+const (
+	SinkFormatJSONL   = "jsonl"
+	SinkFormatAvroOCF = "avro-ocf"
+	SinkFormatParquet = "parquet"
+)
+
+const (
+	SinkNamingPartition = "partition"
+	SinkNamingTime      = "time"
+)
+
+// records are buffered and written in blocks to avro and parquet files
+const sinkBlockSize = 1000
+
+// rough per-record overhead used to estimate the size of buffered records
+const sinkRecordOverhead = 32
+
+const sinkAvroSchema = `{
+  "type": "record",
+  "name": "KafkaMessage",
+  "namespace": "kafkactl",
+  "fields": [
+    {"name": "partition", "type": "int"},
+    {"name": "offset", "type": "long"},
+    {"name": "timestamp", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "key", "type": ["null", "bytes"], "default": null},
+    {"name": "value", "type": ["null", "bytes"], "default": null},
+    {"name": "headers", "type": {"type": "map", "values": "string"}}
+  ]
+}`
+
+type sinkRecord struct {
+	Partition int32             `parquet:"partition"`
+	Offset    int64             `parquet:"offset"`
+	Timestamp time.Time         `parquet:"timestamp,timestamp(millisecond)"`
+	Key       []byte            `parquet:"key,optional"`
+	Value     []byte            `parquet:"value,optional"`
+	Headers   map[string]string `parquet:"headers"`
+}
+
+type sinkEncoder interface {
+	write(msg *Message, record *sinkRecord) error
+	// size returns the (estimated) number of bytes of the file
+	size() int64
+	close() error
+}
+
+type sinkFile struct {
+	path     string
+	file     *os.File
+	encoder  sinkEncoder
+	openedAt time.Time
+}
+
+type fileSink struct {
+	topic          string
+	dir            string
+	format         string
+	naming         string
+	rotateSize     int64
+	rotateInterval time.Duration
+	flags          Flags
+	files          map[int32]*sinkFile
+	messageCount   int64
+	fileCount      int
+}
+
+func newFileSink(topic string, flags Flags) (*fileSink, error) {
+
+	if !strings.HasPrefix(flags.Sink, fileSinkPrefix) {
+		return nil, errors.Errorf("unsupported sink: %s (expected format: file:///path)", flags.Sink)
+	}
+
+	dir := strings.TrimPrefix(flags.Sink, fileSinkPrefix)
+	if dir == "" {
+		return nil, errors.Errorf("sink path must not be empty: %s", flags.Sink)
+	}
+
+	sink := fileSink{
+		topic:          topic,
+		dir:            dir,
+		format:         flags.SinkFormat,
+		naming:         flags.SinkNaming,
+		rotateInterval: flags.RotateInterval,
+		flags:          flags,
+		files:          make(map[int32]*sinkFile),
+	}
+
+	switch sink.format {
+	case "":
+		sink.format = SinkFormatJSONL
+	case SinkFormatJSONL, SinkFormatAvroOCF, SinkFormatParquet:
+	default:
+		return nil, errors.Errorf("unknown sink format: %s", sink.format)
+	}
+
+	switch sink.naming {
+	case "":
+		sink.naming = SinkNamingPartition
+	case SinkNamingPartition, SinkNamingTime:
+	default:
+		return nil, errors.Errorf("unknown sink naming: %s", sink.naming)
+	}
+
+	if flags.RotateSize != "" {
+		var err error
+		if sink.rotateSize, err = util.ParseSize(flags.RotateSize); err != nil {
+			return nil, err
+		}
+	}
+
+	if sink.rotateInterval < 0 {
+		return nil, errors.Errorf("rotate interval must not be negative: %v", sink.rotateInterval)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "unable to create sink directory %s", dir)
+	}
+
+	return &sink, nil
+}
+
+func (sink *fileSink) Write(consumerMsg *sarama.ConsumerMessage, key, value *DeserializedData) error {
+
+	fileKey := consumerMsg.Partition
+	if sink.naming == SinkNamingTime {
+		// all partitions are written to the same file
+		fileKey = -1
+	}
+
+	current, err := sink.currentFile(fileKey, consumerMsg)
+	if err != nil {
+		return err
+	}
+
+	record := sinkRecord{
+		Partition: consumerMsg.Partition,
+		Offset:    consumerMsg.Offset,
+		Timestamp: consumerMsg.Timestamp,
+		Value:     value.data,
+		Headers:   encodeRecordHeaders(consumerMsg.Headers),
+	}
+
+	if key != nil {
+		record.Key = key.data
+	}
+
+	if record.Headers == nil {
+		record.Headers = map[string]string{}
+	}
+
+	if err = current.encoder.write(newMessage(consumerMsg, sink.flags, key, value), &record); err != nil {
+		return errors.Wrapf(err, "failed to write message to %s", current.path)
+	}
+
+	sink.messageCount++
+	return nil
+}
+
+func (sink *fileSink) currentFile(fileKey int32, consumerMsg *sarama.ConsumerMessage) (*sinkFile, error) {
+
+	current, ok := sink.files[fileKey]
+
+	if ok && sink.needsRotation(current) {
+		output.Debugf("rotating sink file: %s", current.path)
+		if err := current.close(); err != nil {
+			return nil, err
+		}
+		ok = false
+	}
+
+	if !ok {
+		var err error
+		if current, err = sink.openFile(consumerMsg); err != nil {
+			return nil, err
+		}
+		sink.files[fileKey] = current
+	}
+
+	return current, nil
+}
+
+func (sink *fileSink) needsRotation(current *sinkFile) bool {
+	if sink.rotateSize > 0 && current.encoder.size() >= sink.rotateSize {
+		return true
+	}
+	return sink.rotateInterval > 0 && time.Since(current.openedAt) >= sink.rotateInterval
+}
+
+func (sink *fileSink) openFile(consumerMsg *sarama.ConsumerMessage) (*sinkFile, error) {
+
+	now := time.Now()
+
+	var baseName string
+	if sink.naming == SinkNamingTime {
+		baseName = fmt.Sprintf("%s-%s", sink.topic, now.UTC().Format("20060102T150405.000Z"))
+	} else {
+		baseName = fmt.Sprintf("%s-%d-%d", sink.topic, consumerMsg.Partition, consumerMsg.Offset)
+	}
+
+	extension := map[string]string{SinkFormatJSONL: "jsonl", SinkFormatAvroOCF: "avro", SinkFormatParquet: "parquet"}[sink.format]
+
+	var (
+		file *os.File
+		path string
+		err  error
+	)
+
+	// never overwrite existing files
+	for i := 0; file == nil; i++ {
+		name := baseName + "." + extension
+		if i > 0 {
+			name = fmt.Sprintf("%s-%d.%s", baseName, i, extension)
+		}
+		path = filepath.Join(sink.dir, name)
+
+		file, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil && !os.IsExist(err) {
+			return nil, errors.Wrapf(err, "unable to create sink file %s", path)
+		}
+	}
+
+	output.Debugf("writing messages to: %s", path)
+	sink.fileCount++
+
+	writer := &countingWriter{writer: file}
+
+	var encoder sinkEncoder
+
+	switch sink.format {
+	case SinkFormatAvroOCF:
+		encoder, err = newAvroSinkEncoder(writer)
+	case SinkFormatParquet:
+		encoder = newParquetSinkEncoder(writer)
+	default:
+		encoder = newJSONLSinkEncoder(writer, sink.flags)
+	}
+
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &sinkFile{path: path, file: file, encoder: encoder, openedAt: now}, nil
+}
+
+func (sink *fileSink) Close() error {
+	var closeErr error
+
+	for _, current := range sink.files {
+		if err := current.close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	sink.files = make(map[int32]*sinkFile)
+
+	output.Infof("%d messages written to %d files in %s", sink.messageCount, sink.fileCount, sink.dir)
+	return closeErr
+}
+
+func (f *sinkFile) close() error {
+	if err := f.encoder.close(); err != nil {
+		_ = f.file.Close()
+		return errors.Wrapf(err, "failed to write sink file %s", f.path)
+	}
+	return f.file.Close()
+}
+
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+type jsonlSinkEncoder struct {
+	writer   *countingWriter
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+	flags    Flags
+}
+
+// jsonlMessage adds the encoding of key and value to the message, so that binary data can be restored.
+// Text is written as is and has no encoding.
+type jsonlMessage struct {
+	*Message
+	KeyEncoding   string `json:"keyEncoding,omitempty"`
+	ValueEncoding string `json:"valueEncoding,omitempty"`
+}
+
+func newJSONLSinkEncoder(writer *countingWriter, flags Flags) *jsonlSinkEncoder {
+	buffered := bufio.NewWriter(writer)
+	return &jsonlSinkEncoder{writer: writer, buffered: buffered, encoder: json.NewEncoder(buffered), flags: flags}
+}
+
+func (e *jsonlSinkEncoder) write(msg *Message, record *sinkRecord) error {
+	line := jsonlMessage{Message: msg}
+
+	line.ValueEncoding = sinkEncoding(record.Value, e.flags.EncodeValue)
+	msg.Value = encodeBytes(record.Value, line.ValueEncoding)
+
+	if msg.Key != nil {
+		line.KeyEncoding = sinkEncoding(record.Key, e.flags.EncodeKey)
+		msg.Key = encodeBytes(record.Key, line.KeyEncoding)
+	}
+
+	return e.encoder.Encode(line)
+}
+
+// sinkEncoding returns the requested encoding or base64 for data that is not valid utf-8, because json strings
+// would replace invalid bytes.
+func sinkEncoding(data []byte, encoding string) string {
+	if encoding == HEX || encoding == BASE64 {
+		return encoding
+	}
+	if !utf8.Valid(data) {
+		return BASE64
+	}
+	return ""
+}
+
+func (e *jsonlSinkEncoder) size() int64 {
+	return e.writer.count + int64(e.buffered.Buffered())
+}
+
+func (e *jsonlSinkEncoder) close() error {
+	return e.buffered.Flush()
+}
+
+type avroSinkEncoder struct {
+	writer       *countingWriter
+	ocfWriter    *goavro.OCFWriter
+	pending      []map[string]any
+	pendingBytes int64
+}
+
+func newAvroSinkEncoder(writer *countingWriter) (*avroSinkEncoder, error) {
+	ocfWriter, err := goavro.NewOCFWriter(goavro.OCFConfig{W: writer, Schema: sinkAvroSchema, CompressionName: goavro.CompressionSnappyLabel})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create avro container file")
+	}
+	return &avroSinkEncoder{writer: writer, ocfWriter: ocfWriter}, nil
+}
+
+func (e *avroSinkEncoder) write(_ *Message, record *sinkRecord) error {
+
+	native := map[string]any{
+		"partition": record.Partition,
+		"offset":    record.Offset,
+		"timestamp": record.Timestamp,
+		"key":       nil,
+		"value":     nil,
+		"headers":   toAnyMap(record.Headers),
+	}
+
+	if record.Key != nil {
+		native["key"] = goavro.Union("bytes", record.Key)
+	}
+	if record.Value != nil {
+		native["value"] = goavro.Union("bytes", record.Value)
+	}
+
+	e.pending = append(e.pending, native)
+	e.pendingBytes += int64(len(record.Key)+len(record.Value)) + sinkRecordOverhead
+
+	if len(e.pending) >= sinkBlockSize {
+		return e.flush()
+	}
+	return nil
+}
+
+func (e *avroSinkEncoder) flush() error {
+	if len(e.pending) == 0 {
+		return nil
+	}
+	if err := e.ocfWriter.Append(e.pending); err != nil {
+		return err
+	}
+	e.pending = e.pending[:0]
+	e.pendingBytes = 0
+	return nil
+}
+
+func (e *avroSinkEncoder) size() int64 {
+	return e.writer.count + e.pendingBytes
+}
+
+func (e *avroSinkEncoder) close() error {
+	return e.flush()
+}
+
+type parquetSinkEncoder struct {
+	writer        *countingWriter
+	parquetWriter *parquet.GenericWriter[sinkRecord]
+	pendingBytes  int64
+	pendingRows   int
+}
+
+func newParquetSinkEncoder(writer *countingWriter) *parquetSinkEncoder {
+	return &parquetSinkEncoder{writer: writer, parquetWriter: parquet.NewGenericWriter[sinkRecord](writer)}
+}
+
+func (e *parquetSinkEncoder) write(_ *Message, record *sinkRecord) error {
+	if _, err := e.parquetWriter.Write([]sinkRecord{*record}); err != nil {
+		return err
+	}
+
+	e.pendingBytes += int64(len(record.Key)+len(record.Value)) + sinkRecordOverhead
+	e.pendingRows++
+
+	if e.pendingRows >= sinkBlockSize {
+		if err := e.parquetWriter.Flush(); err != nil {
+			return err
+		}
+		e.pendingBytes = 0
+		e.pendingRows = 0
+	}
+	return nil
+}
+
+func (e *parquetSinkEncoder) size() int64 {
+	return e.writer.count + e.pendingBytes
+}
+
+func (e *parquetSinkEncoder) close() error {
+	return e.parquetWriter.Close()
+}
+
+func toAnyMap(values map[string]string) map[string]any {
+	result := make(map[string]any, len(values))
+	for k, v := range values {
+		result[k] = v
+	}
+	return result
+}
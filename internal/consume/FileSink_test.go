@@ -0,0 +1,265 @@
+package consume
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/linkedin/goavro/v2"
+	"github.com/parquet-go/parquet-go"
+)
+
+var sinkTestTimestamp = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func sinkTestMessage(partition int32, offset int64, key, value string) *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Partition: partition,
+		Offset:    offset,
+		Timestamp: sinkTestTimestamp,
+		Key:       []byte(key),
+		Value:     []byte(value),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("h"), Value: []byte("v")}},
+	}
+}
+
+func writeSinkTestMessages(t *testing.T, sink *fileSink, messages ...*sarama.ConsumerMessage) {
+	for _, msg := range messages {
+		if err := sink.Write(msg, &DeserializedData{data: msg.Key}, &DeserializedData{data: msg.Value}); err != nil {
+			t.Fatalf("failed to write message: %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("failed to close sink: %v", err)
+	}
+}
+
+func listSinkFiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read dir: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFileSink_JSONLPerPartition(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, PrintKeys: true, PrintHeaders: true, PrintTimestamps: true})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	writeSinkTestMessages(t, sink,
+		sinkTestMessage(0, 10, "k1", "v1"),
+		sinkTestMessage(1, 20, "k2", "v2"),
+		sinkTestMessage(0, 11, "k3", "v3"),
+	)
+
+	files := listSinkFiles(t, dir)
+	expectedFiles := []string{"my-topic-0-10.jsonl", "my-topic-1-20.jsonl"}
+	if len(files) != 2 || files[0] != expectedFiles[0] || files[1] != expectedFiles[1] {
+		t.Fatalf("expected files %v, got %v", expectedFiles, files)
+	}
+
+	file, err := os.Open(filepath.Join(dir, files[0]))
+	if err != nil {
+		t.Fatalf("failed to open file: %v", err)
+	}
+	defer file.Close()
+
+	var values []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var msg Message
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			t.Fatalf("failed to parse line: %v", err)
+		}
+		if msg.Partition != 0 || msg.Headers["h"] != "v" {
+			t.Errorf("unexpected message: %s", scanner.Text())
+		}
+		values = append(values, *msg.Key+"="+*msg.Value)
+	}
+
+	if len(values) != 2 || values[0] != "k1=v1" || values[1] != "k3=v3" {
+		t.Errorf("unexpected messages: %v", values)
+	}
+}
+
+func TestFileSink_JSONLBinaryData(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, PrintKeys: true, EncodeValue: NONE})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	binaryValue := []byte{0x00, 0xff, 0x10, 0xc3}
+	writeSinkTestMessages(t, sink, sinkTestMessage(0, 0, "k1", string(binaryValue)))
+
+	data, err := os.ReadFile(filepath.Join(dir, "my-topic-0-0.jsonl"))
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	var msg jsonlMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("failed to parse line: %v", err)
+	}
+
+	if msg.KeyEncoding != "" || *msg.Key != "k1" {
+		t.Errorf("expected plain key, got %q (encoding %q)", *msg.Key, msg.KeyEncoding)
+	}
+	if msg.ValueEncoding != BASE64 {
+		t.Fatalf("expected value encoding %q, got %q", BASE64, msg.ValueEncoding)
+	}
+	value, err := base64.StdEncoding.DecodeString(*msg.Value)
+	if err != nil || !bytes.Equal(value, binaryValue) {
+		t.Errorf("expected value %v, got %v (%v)", binaryValue, value, err)
+	}
+}
+
+func TestFileSink_RotateSize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, RotateSize: "10B"})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	writeSinkTestMessages(t, sink,
+		sinkTestMessage(0, 0, "k", "a long value exceeding the size"),
+		sinkTestMessage(0, 1, "k", "b"),
+		sinkTestMessage(0, 2, "k", "c"),
+	)
+
+	files := listSinkFiles(t, dir)
+	if len(files) != 3 {
+		t.Fatalf("expected 3 files, got %v", files)
+	}
+}
+
+func TestFileSink_TimeNaming(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, SinkNaming: SinkNamingTime, RotateSize: "1B"})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	writeSinkTestMessages(t, sink,
+		sinkTestMessage(0, 0, "k", "a"),
+		sinkTestMessage(1, 0, "k", "b"),
+	)
+
+	files := listSinkFiles(t, dir)
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, got %v", files)
+	}
+	for _, name := range files {
+		if matched, _ := filepath.Match("my-topic-*T*Z*.jsonl", name); !matched {
+			t.Errorf("unexpected file name: %s", name)
+		}
+	}
+}
+
+func TestFileSink_AvroOCF(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, SinkFormat: SinkFormatAvroOCF})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	binaryValue := string([]byte{0x00, 0xff, 0x10})
+	writeSinkTestMessages(t, sink, sinkTestMessage(2, 5, "k1", binaryValue))
+
+	data, err := os.ReadFile(filepath.Join(dir, "my-topic-2-5.avro"))
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	reader, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read avro file: %v", err)
+	}
+
+	if !reader.Scan() {
+		t.Fatalf("expected a record: %v", reader.Err())
+	}
+
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatalf("failed to read record: %v", err)
+	}
+
+	fields := record.(map[string]any)
+	if fields["offset"].(int64) != 5 || fields["partition"].(int32) != 2 {
+		t.Errorf("unexpected record: %v", fields)
+	}
+	if value := fields["value"].(map[string]any)["bytes"].([]byte); !bytes.Equal(value, []byte(binaryValue)) {
+		t.Errorf("expected binary value to be preserved, got %v", value)
+	}
+	if !fields["timestamp"].(time.Time).Equal(sinkTestTimestamp) {
+		t.Errorf("unexpected timestamp: %v", fields["timestamp"])
+	}
+}
+
+func TestFileSink_Parquet(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink, err := newFileSink("my-topic", Flags{Sink: "file://" + dir, SinkFormat: SinkFormatParquet})
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+
+	writeSinkTestMessages(t, sink,
+		sinkTestMessage(0, 0, "k1", "v1"),
+		sinkTestMessage(0, 1, "k2", "v2"),
+	)
+
+	records, err := parquet.ReadFile[sinkRecord](filepath.Join(dir, "my-topic-0-0.parquet"))
+	if err != nil {
+		t.Fatalf("failed to read parquet file: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if string(records[1].Key) != "k2" || string(records[1].Value) != "v2" || records[1].Offset != 1 || records[1].Headers["h"] != "v" {
+		t.Errorf("unexpected record: %+v", records[1])
+	}
+}
+
+func TestFileSink_InvalidParameters(t *testing.T) {
+	t.Parallel()
+
+	for _, flags := range []Flags{
+		{Sink: "s3://bucket"},
+		{Sink: "file://"},
+		{Sink: "file://" + t.TempDir(), SinkFormat: "csv"},
+		{Sink: "file://" + t.TempDir(), SinkNaming: "offset"},
+		{Sink: "file://" + t.TempDir(), RotateSize: "lots"},
+	} {
+		if _, err := newFileSink("my-topic", flags); err == nil {
+			t.Errorf("expected error for flags: %+v", flags)
+		}
+	}
+}
@@ -8,7 +8,7 @@ import (
 
 type MessageDeserializerChain []MessageDeserializer
 
-func (deserializer *MessageDeserializerChain) Deserialize(consumerMsg *sarama.ConsumerMessage, flags Flags, filter *MessageFilter) error {
+func (deserializer *MessageDeserializerChain) Deserialize(consumerMsg *sarama.ConsumerMessage, flags Flags, filter *MessageFilter, sink MessageSink) error {
 
 	var key, value *DeserializedData
 	var err error
@@ -59,7 +59,5 @@ func (deserializer *MessageDeserializerChain) Deserialize(consumerMsg *sarama.Co
 		return nil
 	}
 
-	// print message
-	msg := newMessage(consumerMsg, flags, key, value)
-	return printMessage(msg, flags)
+	return sink.Write(consumerMsg, key, value)
 }
@@ -9,7 +9,8 @@ import (
 	"github.com/deviceinsight/kafkactl/v5/internal/output"
 )
 
-type message struct {
+// Message is a deserialized message as it is printed or written to a sink.
+type Message struct {
 	Partition     int32
 	Offset        int64
 	Headers       map[string]string `json:",omitempty" yaml:",omitempty"`
@@ -28,9 +29,9 @@ type DeserializedData struct {
 	data     []byte
 }
 
-func newMessage(consumerMsg *sarama.ConsumerMessage, flags Flags, key, value *DeserializedData) *message {
+func newMessage(consumerMsg *sarama.ConsumerMessage, flags Flags, key, value *DeserializedData) *Message {
 
-	msg := message{
+	msg := Message{
 		Partition: consumerMsg.Partition,
 		Offset:    consumerMsg.Offset,
 		Value:     encodeBytes(value.data, flags.EncodeValue),
@@ -64,7 +65,7 @@ func newMessage(consumerMsg *sarama.ConsumerMessage, flags Flags, key, value *De
 	return &msg
 }
 
-func printMessage(msg *message, flags Flags) error {
+func printMessage(msg *Message, flags Flags) error {
 
 	if flags.OutputFormat == "" {
 		var row []string
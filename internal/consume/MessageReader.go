@@ -0,0 +1,90 @@
+package consume
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+)
+
+// readIdleTimeout limits the time waiting for the next message, e.g. when the remaining offsets
+// of a partition only contain transaction markers.
+const readIdleTimeout = 5 * time.Second
+
+// CollectingSink collects deserialized messages in memory instead of printing them.
+type CollectingSink struct {
+	Messages []*Message
+	flags    Flags
+}
+
+func NewCollectingSink(flags Flags) *CollectingSink {
+	return &CollectingSink{flags: flags}
+}
+
+func (sink *CollectingSink) Write(consumerMsg *sarama.ConsumerMessage, key, value *DeserializedData) error {
+	sink.Messages = append(sink.Messages, newMessage(consumerMsg, sink.flags, key, value))
+	return nil
+}
+
+func (sink *CollectingSink) Close() error {
+	return nil
+}
+
+// ReadMessages reads up to count messages of a partition beginning at the given offset and deserializes them.
+// Reading stops early when the newest offset of the partition is reached.
+func ReadMessages(client sarama.Client, topic string, partition int32, offset int64, count int,
+	deserializers MessageDeserializerChain, flags Flags,
+) ([]*Message, error) {
+
+	oldestOffset, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get oldest offset for topic %s partition %d", topic, partition)
+	}
+
+	newestOffset, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get newest offset for topic %s partition %d", topic, partition)
+	}
+
+	if offset < oldestOffset {
+		offset = oldestOffset
+	}
+
+	sink := NewCollectingSink(flags)
+
+	if offset >= newestOffset || count <= 0 {
+		return sink.Messages, nil
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create consumer")
+	}
+	defer consumer.Close()
+
+	partitionConsumer, err := consumer.ConsumePartition(topic, partition, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to consume topic %s partition %d", topic, partition)
+	}
+	defer partitionConsumer.Close()
+
+	filter := &MessageFilter{}
+
+	for len(sink.Messages) < count {
+		select {
+		case msg := <-partitionConsumer.Messages():
+			if err := deserializers.Deserialize(msg, flags, filter, sink); err != nil {
+				return nil, err
+			}
+			if msg.Offset >= newestOffset-1 {
+				return sink.Messages, nil
+			}
+		case consumerErr := <-partitionConsumer.Errors():
+			return nil, consumerErr
+		case <-time.After(readIdleTimeout):
+			return sink.Messages, nil
+		}
+	}
+
+	return sink.Messages, nil
+}
@@ -0,0 +1,31 @@
+package consume
+
+import (
+	"github.com/IBM/sarama"
+)
+
+// MessageSink receives the deserialized messages. By default, messages are printed to stdout.
+type MessageSink interface {
+	Write(consumerMsg *sarama.ConsumerMessage, key, value *DeserializedData) error
+	Close() error
+}
+
+type printSink struct {
+	flags Flags
+}
+
+func (sink *printSink) Write(consumerMsg *sarama.ConsumerMessage, key, value *DeserializedData) error {
+	return printMessage(newMessage(consumerMsg, sink.flags, key, value), sink.flags)
+}
+
+func (sink *printSink) Close() error {
+	return nil
+}
+
+// CreateMessageSink creates the sink for consumed messages according to the given flags.
+func CreateMessageSink(topic string, flags Flags) (MessageSink, error) {
+	if flags.Sink == "" {
+		return &printSink{flags: flags}, nil
+	}
+	return newFileSink(topic, flags)
+}
@@ -49,6 +49,12 @@ type Flags struct {
 	ValueProtoType      string
 	IsolationLevel      string
 
+	Sink           string
+	SinkFormat     string
+	SinkNaming     string
+	RotateSize     string
+	RotateInterval time.Duration
+
 	FilterKey    string
 	FilterValue  string
 	FilterHeader map[string]string
@@ -97,37 +103,11 @@ func (operation *Operation) Consume(topic string, flags Flags) error {
 		return errors.Errorf("topic '%s' does not exist", topic)
 	}
 
-	var schemaRegistryClient *internal.CachingSchemaRegistry
-
-	if clientContext.SchemaRegistry.URL != "" {
-		schemaRegistryClient, err = internal.CreateCachingSchemaRegistry(&clientContext)
-		if err != nil {
-			return err
-		}
-	}
-
-	var deserializers MessageDeserializerChain
-	var protobufConfig internal.ProtobufConfig
-
-	if protobufConfig, err = addFlagsToProtobufConfig(clientContext.Protobuf, flags); err != nil {
-		return err
-	}
-
-	if schemaRegistryClient != nil {
-		avroDeserializer := AvroMessageDeserializer{topic: topic, registry: schemaRegistryClient, jsonCodec: clientContext.Avro.JSONCodec}
-		protobufDeserializer := RegistryProtobufMessageDeserializer{config: protobufConfig, registry: schemaRegistryClient}
-		jsonSchemaDeserializer := JSONSchemaMessageDeserializer{topic: topic, registry: schemaRegistryClient}
-		deserializers = append(deserializers, &avroDeserializer, &protobufDeserializer, &jsonSchemaDeserializer)
-	}
-
-	deserializer, err := CreateProtobufMessageDeserializer(protobufConfig, protoreflect.FullName(flags.KeyProtoType), protoreflect.FullName(flags.ValueProtoType))
+	deserializers, err := CreateMessageDeserializerChain(topic, clientContext, flags)
 	if err != nil {
 		return err
 	}
 
-	deserializers = append(deserializers, deserializer)
-	deserializers = append(deserializers, &DefaultMessageDeserializer{})
-
 	if flags.Group != "" {
 		if flags.Exit {
 			return errors.New("parameters --group and --exit cannot be used together")
@@ -146,6 +126,35 @@ func (operation *Operation) Consume(topic string, flags Flags) error {
 		}
 	}
 
+	if flags.Sink != "" {
+		if flags.OutputFormat != "" {
+			return errors.New("parameters --sink and --output cannot be used together")
+		}
+		if flags.Tail > 0 {
+			return errors.New("parameters --sink and --tail cannot be used together")
+		}
+		// sinks always contain all message details
+		flags.PrintKeys = true
+		flags.PrintHeaders = true
+		flags.PrintPartitions = true
+		flags.PrintTimestamps = true
+	} else if flags.SinkFormat != "" || flags.SinkNaming != "" || flags.RotateSize != "" || flags.RotateInterval != 0 {
+		return errors.New("parameters --format, --sink-naming, --rotate-size and --rotate-interval require --sink")
+	}
+
+	sink, err := CreateMessageSink(topic, flags)
+	if err != nil {
+		return err
+	}
+
+	// the sink is closed on errors as well, so that buffered messages are written and files stay valid
+	sinkClosed := false
+	defer func() {
+		if !sinkClosed {
+			_ = sink.Close()
+		}
+	}()
+
 	messages := make(chan *sarama.ConsumerMessage)
 	stopConsumers := make(chan bool)
 
@@ -174,9 +183,11 @@ func (operation *Operation) Consume(topic string, flags Flags) error {
 		return err
 	}
 
-	deserializationGroup := deserializeMessages(ctx, flags, messages, stopConsumers, deserializers, messageFilter)
+	deserializationGroup := deserializeMessages(ctx, flags, messages, stopConsumers, deserializers, messageFilter, sink)
 
 	if err := consumer.Wait(); err != nil {
+		close(messages)
+		_ = deserializationGroup.Wait()
 		return errors.Wrap(err, "Failed while waiting for consumer")
 	}
 
@@ -188,6 +199,11 @@ func (operation *Operation) Consume(topic string, flags Flags) error {
 	}
 	output.Debugf("deserialization finished")
 
+	sinkClosed = true
+	if err := sink.Close(); err != nil {
+		return errors.Wrap(err, "Failed to close sink")
+	}
+
 	if err := consumer.Close(); err != nil {
 		return errors.Wrap(err, "Failed to close consumer")
 	}
@@ -195,6 +211,44 @@ func (operation *Operation) Consume(topic string, flags Flags) error {
 	return nil
 }
 
+// CreateMessageDeserializerChain creates the chain of deserializers used to decode the messages of a topic.
+func CreateMessageDeserializerChain(topic string, clientContext internal.ClientContext, flags Flags) (MessageDeserializerChain, error) {
+	var err error
+
+	var schemaRegistryClient *internal.CachingSchemaRegistry
+
+	if clientContext.SchemaRegistry.URL != "" {
+		schemaRegistryClient, err = internal.CreateCachingSchemaRegistry(&clientContext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deserializers MessageDeserializerChain
+	var protobufConfig internal.ProtobufConfig
+
+	if protobufConfig, err = addFlagsToProtobufConfig(clientContext.Protobuf, flags); err != nil {
+		return nil, err
+	}
+
+	if schemaRegistryClient != nil {
+		avroDeserializer := AvroMessageDeserializer{topic: topic, registry: schemaRegistryClient, jsonCodec: clientContext.Avro.JSONCodec}
+		protobufDeserializer := RegistryProtobufMessageDeserializer{config: protobufConfig, registry: schemaRegistryClient}
+		jsonSchemaDeserializer := JSONSchemaMessageDeserializer{topic: topic, registry: schemaRegistryClient}
+		deserializers = append(deserializers, &avroDeserializer, &protobufDeserializer, &jsonSchemaDeserializer)
+	}
+
+	deserializer, err := CreateProtobufMessageDeserializer(protobufConfig, protoreflect.FullName(flags.KeyProtoType), protoreflect.FullName(flags.ValueProtoType))
+	if err != nil {
+		return nil, err
+	}
+
+	deserializers = append(deserializers, deserializer)
+	deserializers = append(deserializers, &DefaultMessageDeserializer{})
+
+	return deserializers, nil
+}
+
 func addFlagsToProtobufConfig(protobufConfig internal.ProtobufConfig, flags Flags) (internal.ProtobufConfig, error) {
 
 	protobufConfig.ProtosetFiles = append(flags.ProtosetFiles, protobufConfig.ProtosetFiles...)
@@ -244,7 +298,7 @@ func parseIsolationLevel(isolationLevel string) (sarama.IsolationLevel, error) {
 }
 
 func deserializeMessages(ctx context.Context, flags Flags, messages <-chan *sarama.ConsumerMessage,
-	stopConsumers chan<- bool, deserializers MessageDeserializerChain, filter *MessageFilter,
+	stopConsumers chan<- bool, deserializers MessageDeserializerChain, filter *MessageFilter, sink MessageSink,
 ) *errgroup.Group {
 	errorGroup, _ := errgroup.WithContext(ctx)
 
@@ -260,7 +314,7 @@ func deserializeMessages(ctx context.Context, flags Flags, messages <-chan *sara
 			}
 			lastIndex := len(sortedMessages) - 1
 			for i := range sortedMessages {
-				err := deserializers.Deserialize(sortedMessages[lastIndex-i], flags, filter)
+				err := deserializers.Deserialize(sortedMessages[lastIndex-i], flags, filter, sink)
 				if err != nil {
 					return err
 				}
@@ -275,7 +329,7 @@ func deserializeMessages(ctx context.Context, flags Flags, messages <-chan *sara
 			var err error
 
 			for msg := range messages {
-				err = deserializers.Deserialize(msg, flags, filter)
+				err = deserializers.Deserialize(msg, flags, filter, sink)
 				messageCount++
 				if err != nil {
 					close(stopConsumers)
@@ -0,0 +1,201 @@
+package consume
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/producer"
+	"github.com/pkg/errors"
+)
+
+type FindFlags struct {
+	Key              string
+	KeyEncoding      string
+	Partitioner      string
+	FromTimestamp    string
+	ToTimestamp      string
+	OutputFormat     string
+	EncodeValue      string
+	ProtoFiles       []string
+	ProtoImportPaths []string
+	ProtosetFiles    []string
+	KeyProtoType     string
+	ValueProtoType   string
+	IsolationLevel   string
+}
+
+// Find looks up messages with the given key. Only the partition the configured partitioner
+// assigns the key to is scanned.
+func (operation *Operation) Find(topic string, flags FindFlags) error {
+	var (
+		clientContext internal.ClientContext
+		err           error
+		client        sarama.Client
+		topExists     bool
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if clientContext, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	consumeFlags := Flags{
+		PrintKeys:        true,
+		PrintPartitions:  true,
+		PrintTimestamps:  true,
+		FromTimestamp:    flags.FromTimestamp,
+		ToTimestamp:      flags.ToTimestamp,
+		FromBeginning:    flags.FromTimestamp == "",
+		Exit:             true,
+		EncodeValue:      flags.EncodeValue,
+		EncodeKey:        flags.KeyEncoding,
+		ProtoFiles:       flags.ProtoFiles,
+		ProtoImportPaths: flags.ProtoImportPaths,
+		ProtosetFiles:    flags.ProtosetFiles,
+		KeyProtoType:     flags.KeyProtoType,
+		ValueProtoType:   flags.ValueProtoType,
+		IsolationLevel:   flags.IsolationLevel,
+	}
+
+	config, err := internal.CreateClientConfig(&clientContext)
+	if err != nil {
+		return err
+	}
+
+	if err = applyConsumerConfigs(config, clientContext, consumeFlags); err != nil {
+		return err
+	}
+
+	if client, err = sarama.NewClient(clientContext.Brokers, config); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if topExists, err = internal.TopicExists(&client, topic); err != nil {
+		return errors.Wrap(err, "failed to read topics")
+	}
+
+	if !topExists {
+		return errors.Errorf("topic '%s' does not exist", topic)
+	}
+
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the list of partitions")
+	}
+
+	producerFlags := producer.Flags{
+		Partitioner:      flags.Partitioner,
+		KeyEncoding:      flags.KeyEncoding,
+		ProtoFiles:       flags.ProtoFiles,
+		ProtoImportPaths: flags.ProtoImportPaths,
+		ProtosetFiles:    flags.ProtosetFiles,
+		KeyProtoType:     flags.KeyProtoType,
+	}
+
+	partition, keyBytes, err := producer.PartitionForKey(topic, flags.Key, int32(len(partitions)), clientContext, producerFlags)
+	if err != nil {
+		return err
+	}
+
+	output.Debugf("key %q is assigned to partition %d", flags.Key, partition)
+
+	deserializers, err := CreateMessageDeserializerChain(topic, clientContext, consumeFlags)
+	if err != nil {
+		return err
+	}
+
+	messages, err := findMessages(client, topic, partition, keyBytes, deserializers, consumeFlags)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return errors.Errorf("no message with key %q found in partition %d of topic %s", flags.Key, partition, topic)
+	}
+
+	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
+		return output.PrintObject(messages, flags.OutputFormat)
+	}
+
+	tableWriter := output.CreateTableWriter()
+
+	if err := tableWriter.WriteHeader("PARTITION", "OFFSET", "TIMESTAMP", "VALUE"); err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		timestamp := ""
+		if message.Timestamp != nil {
+			timestamp = message.Timestamp.Format(time.RFC3339Nano)
+		}
+		value := "null"
+		if message.Value != nil {
+			value = *message.Value
+		}
+		if err := tableWriter.Write(strconv.Itoa(int(message.Partition)), strconv.FormatInt(message.Offset, 10), timestamp, value); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func findMessages(client sarama.Client, topic string, partition int32, keyBytes []byte,
+	deserializers MessageDeserializerChain, flags Flags,
+) ([]*Message, error) {
+
+	startOffset, endOffset, err := getOffsetBounds(&client, topic, flags, partition)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := NewCollectingSink(flags)
+
+	if endOffset == sarama.OffsetNewest || startOffset > endOffset {
+		output.Debugf("no messages to scan in partition %d", partition)
+		return sink.Messages, nil
+	}
+
+	output.Debugf("scanning partition %d from offset %d to %d", partition, startOffset, endOffset)
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create consumer")
+	}
+	defer consumer.Close()
+
+	partitionConsumer, err := consumer.ConsumePartition(topic, partition, startOffset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to consume topic %s partition %d", topic, partition)
+	}
+	defer partitionConsumer.Close()
+
+	filter := &MessageFilter{}
+
+	for {
+		select {
+		case msg := <-partitionConsumer.Messages():
+			if bytes.Equal(msg.Key, keyBytes) {
+				if err := deserializers.Deserialize(msg, flags, filter, sink); err != nil {
+					return nil, err
+				}
+			}
+			if msg.Offset >= endOffset {
+				return sink.Messages, nil
+			}
+		case consumerErr := <-partitionConsumer.Errors():
+			return nil, consumerErr
+		case <-time.After(readIdleTimeout):
+			output.Warnf("timed-out while waiting for messages on partition %d", partition)
+			return sink.Messages, nil
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package consume
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+type GetOffsetsFlags struct {
+	Partitions   []int
+	Offsets      []string
+	AtTimestamp  string
+	OutputFormat string
+}
+
+// PartitionOffsets describes the offsets of a partition. Messages is an estimate, as compacted
+// messages and transaction markers are counted as well.
+type PartitionOffsets struct {
+	Topic        string
+	Partition    int32
+	OldestOffset int64
+	NewestOffset int64
+	OffsetAtTime *int64 `json:"offsetAtTime,omitempty" yaml:"offsetAtTime,omitempty"`
+	Offset       *int64 `json:"offset,omitempty" yaml:"offset,omitempty"`
+	Remaining    *int64 `json:"remaining,omitempty" yaml:"remaining,omitempty"`
+	Messages     int64
+}
+
+// GetOffsets prints oldest and newest offsets for the partitions of the given topics. Topics may be glob patterns.
+// When a timestamp is given, the offset of the first message at or after this timestamp is included. If there
+// is no such message, the newest offset is used. Offsets are given in the same `partition=offset` format as for
+// consume and additionally print the number of messages remaining after the offset.
+func (operation *Operation) GetOffsets(topics []string, flags GetOffsetsFlags) error {
+	var (
+		clientContext internal.ClientContext
+		err           error
+		client        sarama.Client
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if _, err = ConvertToEpocUnixMillis(flags.AtTimestamp); err != nil {
+		return err
+	}
+
+	requestedOffsets, err := util.ParseOffsets(flags.Offsets)
+	if err != nil {
+		return err
+	}
+
+	if clientContext, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&clientContext); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	topicNames, err := topic.SelectTopics(client, topics, topic.SelectTopicsFlags{})
+	if err != nil {
+		return err
+	}
+
+	offsets := make([]PartitionOffsets, 0)
+
+	for _, topicName := range topicNames {
+		partitions, err := selectPartitions(client, topicName, flags.Partitions, requestedOffsets)
+		if err != nil {
+			return err
+		}
+
+		for _, partition := range partitions {
+			partitionOffsets, err := readPartitionOffsets(client, topicName, partition, flags.AtTimestamp)
+			if err != nil {
+				return err
+			}
+			if offset, ok := requestedOffsets[partition]; ok {
+				remaining := max(partitionOffsets.NewestOffset-max(offset, partitionOffsets.OldestOffset), 0)
+				partitionOffsets.Offset = &offset
+				partitionOffsets.Remaining = &remaining
+			}
+			offsets = append(offsets, partitionOffsets)
+		}
+	}
+
+	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
+		return output.PrintObject(offsets, flags.OutputFormat)
+	}
+
+	tableWriter := output.CreateTableWriter()
+
+	columns := []string{"TOPIC", "PARTITION", "OLDEST_OFFSET", "NEWEST_OFFSET"}
+	if flags.AtTimestamp != "" {
+		columns = append(columns, "OFFSET_AT_TIME")
+	}
+	if len(requestedOffsets) > 0 {
+		columns = append(columns, "OFFSET", "REMAINING")
+	}
+	columns = append(columns, "MESSAGES")
+
+	if err := tableWriter.WriteHeader(columns...); err != nil {
+		return err
+	}
+
+	for _, offset := range offsets {
+		values := []string{offset.Topic, strconv.Itoa(int(offset.Partition)),
+			strconv.FormatInt(offset.OldestOffset, 10), strconv.FormatInt(offset.NewestOffset, 10)}
+		if offset.OffsetAtTime != nil {
+			values = append(values, strconv.FormatInt(*offset.OffsetAtTime, 10))
+		}
+		if offset.Offset != nil {
+			values = append(values, strconv.FormatInt(*offset.Offset, 10), strconv.FormatInt(*offset.Remaining, 10))
+		} else if len(requestedOffsets) > 0 {
+			values = append(values, "", "")
+		}
+		values = append(values, strconv.FormatInt(offset.Messages, 10))
+
+		if err := tableWriter.Write(values...); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func readPartitionOffsets(client sarama.Client, topic string, partition int32, atTimestamp string) (PartitionOffsets, error) {
+
+	// with --exit the bounds range from the oldest to the newest offset, the latter being inclusive
+	startOffset, endOffset, err := getOffsetBounds(&client, topic, Flags{FromBeginning: true, Exit: true}, partition)
+	if err != nil {
+		return PartitionOffsets{}, errors.Wrapf(err, "failed to get offsets for topic %s partition %d", topic, partition)
+	}
+
+	newestOffset := endOffset + 1
+	if endOffset == sarama.OffsetNewest {
+		// empty partition
+		newestOffset = startOffset
+	}
+
+	partitionOffsets := PartitionOffsets{
+		Topic:        topic,
+		Partition:    partition,
+		OldestOffset: startOffset,
+		NewestOffset: newestOffset,
+		Messages:     newestOffset - startOffset,
+	}
+
+	if atTimestamp != "" {
+		offsetAtTime, err := getStartOffset(&client, topic, Flags{FromTimestamp: atTimestamp}, partition)
+		if err != nil {
+			return PartitionOffsets{}, errors.Wrapf(err, "failed to get offset for timestamp on topic %s partition %d", topic, partition)
+		}
+		if offsetAtTime == sarama.OffsetNewest {
+			offsetAtTime = newestOffset
+		}
+		partitionOffsets.OffsetAtTime = &offsetAtTime
+	}
+
+	return partitionOffsets, nil
+}
+
+// selectPartitions returns the requested partitions together with the partitions an offset is given for.
+// If neither is given, all partitions of the topic are returned.
+func selectPartitions(client sarama.Client, topic string, requestedPartitions []int, requestedOffsets map[int32]int64) ([]int32, error) {
+
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the list of partitions for topic %s", topic)
+	}
+
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	if len(requestedPartitions) == 0 && len(requestedOffsets) == 0 {
+		return partitions, nil
+	}
+
+	requested := make([]int32, 0, len(requestedPartitions)+len(requestedOffsets))
+	for _, partition := range requestedPartitions {
+		requested = append(requested, int32(partition))
+	}
+	for partition := range requestedOffsets {
+		requested = append(requested, partition)
+	}
+	sort.Slice(requested, func(i, j int) bool { return requested[i] < requested[j] })
+
+	selected := make([]int32, 0, len(requested))
+	for _, partition := range requested {
+		if !util.ContainsInt32(partitions, partition) {
+			return nil, errors.Errorf("partition %d does not exist for topic %s", partition, topic)
+		}
+		if !util.ContainsInt32(selected, partition) {
+			selected = append(selected, partition)
+		}
+	}
+
+	return selected, nil
+}
@@ -1,16 +1,20 @@
 package consumergroupoffsets
 
 import (
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/deviceinsight/kafkactl/v5/internal/consume"
 	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 )
 
 type partitionOffsets struct {
+	Topic         string `json:"topic" yaml:"topic"`
 	Partition     int32
 	OldestOffset  int64 `json:"oldestOffset" yaml:"oldestOffset"`
 	NewestOffset  int64 `json:"newestOffset" yaml:"newestOffset"`
@@ -24,6 +28,35 @@ type OffsetResettingConsumer struct {
 	groupName string
 	topicName string
 	flags     ResetConsumerGroupOffsetFlags
+	// targetOffsets contains the offsets per partition when resetting from a file
+	targetOffsets map[int32]int64
+}
+
+func (consumer *OffsetResettingConsumer) partitions() ([]int32, error) {
+
+	if consumer.targetOffsets == nil && consumer.flags.Partition > -1 {
+		return []int32{consumer.flags.Partition}, nil
+	}
+
+	partitions, err := consumer.client.Partitions(consumer.topicName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list partitions")
+	}
+
+	if consumer.targetOffsets == nil {
+		return partitions, nil
+	}
+
+	selected := make([]int32, 0, len(consumer.targetOffsets))
+	for partition := range consumer.targetOffsets {
+		if !util.ContainsInt32(partitions, partition) {
+			return nil, errors.Errorf("partition %d does not exist for topic %s", partition, consumer.topicName)
+		}
+		selected = append(selected, partition)
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i] < selected[j] })
+
+	return selected, nil
 }
 
 func (consumer *OffsetResettingConsumer) Setup(session sarama.ConsumerGroupSession) error {
@@ -48,43 +81,30 @@ func (consumer *OffsetResettingConsumer) Setup(session sarama.ConsumerGroupSessi
 		return errors.Wrap(err, "failed to get fetch group offsets")
 	}
 
-	offsets := make([]partitionOffsets, 0)
-
-	if flags.Partition > -1 {
-		offset, err := resetOffset(&consumer.client, consumer.topicName, flags.Partition, flags, groupOffsets, session)
-		if err != nil {
-			return err
-		}
-		offsets = append(offsets, offset)
-	} else {
-
-		partitions, err := consumer.client.Partitions(consumer.topicName)
-		if err != nil {
-			return errors.Wrap(err, "failed to list partitions")
-		}
+	partitions, err := consumer.partitions()
+	if err != nil {
+		return err
+	}
 
-		results := make([]partitionOffsets, len(partitions))
-		var resetErrorGroup errgroup.Group
-		resetErrorGroup.SetLimit(100)
-
-		for i, partition := range partitions {
-			index := i
-			partition := partition
-			resetErrorGroup.Go(func() error {
-				offset, err := resetOffset(&consumer.client, consumer.topicName, partition, flags, groupOffsets, session)
-				if err != nil {
-					return err
-				}
-				results[index] = offset
-				return nil
-			})
-		}
+	offsets := make([]partitionOffsets, len(partitions))
+	var resetErrorGroup errgroup.Group
+	resetErrorGroup.SetLimit(100)
 
-		if err := resetErrorGroup.Wait(); err != nil {
-			return err
-		}
+	for i, partition := range partitions {
+		index := i
+		partition := partition
+		resetErrorGroup.Go(func() error {
+			offset, err := resetOffset(&consumer.client, consumer.topicName, partition, flags, consumer.targetOffsets, groupOffsets, session)
+			if err != nil {
+				return err
+			}
+			offsets[index] = offset
+			return nil
+		})
+	}
 
-		offsets = append(offsets, results...)
+	if err := resetErrorGroup.Wait(); err != nil {
+		return err
 	}
 
 	if flags.OutputFormat != "" {
@@ -119,14 +139,12 @@ func (consumer *OffsetResettingConsumer) ConsumeClaim(sarama.ConsumerGroupSessio
 	return nil
 }
 
-func resetOffset(client *sarama.Client, topic string, partition int32, flags ResetConsumerGroupOffsetFlags, groupOffsets *sarama.OffsetFetchResponse, session sarama.ConsumerGroupSession) (partitionOffsets, error) {
-	offset, err := getPartitionOffsets(client, topic, partition, flags)
+func resetOffset(client *sarama.Client, topic string, partition int32, flags ResetConsumerGroupOffsetFlags, targetOffsets map[int32]int64, groupOffsets *sarama.OffsetFetchResponse, session sarama.ConsumerGroupSession) (partitionOffsets, error) {
+	offset, err := getPartitionOffsets(client, topic, partition, getGroupOffset(groupOffsets, topic, partition), targetOffsets, flags)
 	if err != nil {
 		return offset, err
 	}
 
-	offset.CurrentOffset = getGroupOffset(groupOffsets, topic, partition)
-
 	if flags.Execute {
 		if offset.TargetOffset > offset.CurrentOffset {
 			session.MarkOffset(topic, partition, offset.TargetOffset, "")
@@ -138,20 +156,10 @@ func resetOffset(client *sarama.Client, topic string, partition int32, flags Res
 	return offset, nil
 }
 
-func getPartitionOffsets(client *sarama.Client, topic string, partition int32, flags ResetConsumerGroupOffsetFlags) (partitionOffsets, error) {
+func getPartitionOffsets(client *sarama.Client, topic string, partition int32, currentOffset int64, targetOffsets map[int32]int64, flags ResetConsumerGroupOffsetFlags) (partitionOffsets, error) {
 
 	var err error
-	offsets := partitionOffsets{Partition: partition}
-
-	if flags.ToDatetime != "" {
-		milliTime, err := consume.ConvertToEpocUnixMillis(flags.ToDatetime)
-		if err != nil {
-			return offsets, err
-		}
-		if offsets.TargetOffset, err = (*client).GetOffset(topic, partition, milliTime); err == nil {
-			return offsets, nil
-		}
-	}
+	offsets := partitionOffsets{Topic: topic, Partition: partition, CurrentOffset: currentOffset}
 
 	if offsets.OldestOffset, err = (*client).GetOffset(topic, partition, sarama.OffsetOldest); err != nil {
 		return offsets, errors.Errorf("failed to get offset for topic %s Partition %d: %v", topic, partition, err)
@@ -161,26 +169,55 @@ func getPartitionOffsets(client *sarama.Client, topic string, partition int32, f
 		return offsets, errors.Errorf("failed to get offset for topic %s Partition %d: %v", topic, partition, err)
 	}
 
-	if flags.Offset > -1 {
-		if flags.Offset < offsets.OldestOffset {
-			return offsets, errors.Errorf("cannot set offset for Partition %d: offset (%d) < oldest offset (%d)", partition, flags.Offset, offsets.OldestOffset)
-		} else if flags.Offset > offsets.NewestOffset {
-			return offsets, errors.Errorf("cannot set offset for Partition %d: offset (%d) > newest offset (%d)", partition, flags.Offset, offsets.NewestOffset)
+	switch {
+	case targetOffsets != nil:
+		if offsets.TargetOffset, err = checkOffsetRange(offsets, targetOffsets[partition]); err != nil {
+			return offsets, err
 		}
-		offsets.TargetOffset = flags.Offset
-	} else {
-		if flags.OldestOffset {
-			offsets.TargetOffset = offsets.OldestOffset
-		} else if flags.NewestOffset {
+	case flags.Offset > -1:
+		if offsets.TargetOffset, err = checkOffsetRange(offsets, flags.Offset); err != nil {
+			return offsets, err
+		}
+	case flags.OldestOffset:
+		offsets.TargetOffset = offsets.OldestOffset
+	case flags.NewestOffset:
+		offsets.TargetOffset = offsets.NewestOffset
+	case flags.ToDatetime != "" || flags.ByDuration > 0:
+		milliTime := time.Now().Add(-flags.ByDuration).UnixMilli()
+		if flags.ToDatetime != "" {
+			if milliTime, err = consume.ConvertToEpocUnixMillis(flags.ToDatetime); err != nil {
+				return offsets, err
+			}
+		}
+		if offsets.TargetOffset, err = (*client).GetOffset(topic, partition, milliTime); err != nil {
+			return offsets, errors.Errorf("failed to get offset for topic %s Partition %d: %v", topic, partition, err)
+		}
+		if offsets.TargetOffset == sarama.OffsetNewest {
+			// no messages after the given time
 			offsets.TargetOffset = offsets.NewestOffset
-		} else {
-			return offsets, errors.New("either offset,oldest,newest parameter needs to be specified")
 		}
+	case flags.ToCurrent || flags.ShiftBy != 0:
+		if offsets.CurrentOffset < 0 {
+			return offsets, errors.Errorf("cannot set offset for Partition %d: consumer group has no committed offset", partition)
+		}
+		// shifting beyond the available offsets moves the offset to the oldest or newest offset
+		offsets.TargetOffset = min(max(offsets.CurrentOffset+flags.ShiftBy, offsets.OldestOffset), offsets.NewestOffset)
+	default:
+		return offsets, errors.New("either offset,oldest,newest,to-datetime,by-duration,shift-by,to-current or from-file parameter needs to be specified")
 	}
 
 	return offsets, nil
 }
 
+func checkOffsetRange(offsets partitionOffsets, offset int64) (int64, error) {
+	if offset < offsets.OldestOffset {
+		return offset, errors.Errorf("cannot set offset for Partition %d: offset (%d) < oldest offset (%d)", offsets.Partition, offset, offsets.OldestOffset)
+	} else if offset > offsets.NewestOffset {
+		return offset, errors.Errorf("cannot set offset for Partition %d: offset (%d) > newest offset (%d)", offsets.Partition, offset, offsets.NewestOffset)
+	}
+	return offset, nil
+}
+
 func getGroupOffset(offsetFetchResponse *sarama.OffsetFetchResponse, topic string, partition int32) int64 {
 	block := offsetFetchResponse.Blocks[topic][partition]
 	if block != nil {
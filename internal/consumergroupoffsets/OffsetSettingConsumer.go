@@ -5,6 +5,8 @@ import "github.com/IBM/sarama"
 type OffsetSettingConsumer struct {
 	Topic            string
 	PartitionOffsets map[int32]PartitionOffset
+	// Rewind allows to move committed offsets backward, e.g. when importing a snapshot
+	Rewind bool
 
 	ready chan struct{}
 }
@@ -14,6 +16,10 @@ func (s *OffsetSettingConsumer) Setup(session sarama.ConsumerGroupSession) error
 
 	for partition, offset := range s.PartitionOffsets {
 		session.MarkOffset(s.Topic, partition, offset.Offset, offset.Metadata)
+		if s.Rewind {
+			// MarkOffset only moves the offset forward, ResetOffset only backward
+			session.ResetOffset(s.Topic, partition, offset.Offset, offset.Metadata)
+		}
 	}
 
 	return nil
@@ -1,6 +1,9 @@
 package consumergroupoffsets
 
 import (
+	"sort"
+	"time"
+
 	"github.com/deviceinsight/kafkactl/v5/internal/helpers"
 	"golang.org/x/sync/errgroup"
 
@@ -21,17 +24,47 @@ type ResetConsumerGroupOffsetFlags struct {
 	Execute      bool
 	OutputFormat string
 	ToDatetime   string
+	ByDuration   time.Duration
+	ShiftBy      int64
+	ToCurrent    bool
+	FromFile     string
 }
 
 type ConsumerGroupOffsetOperation struct {
 }
 
 func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags ResetConsumerGroupOffsetFlags, groupName string) error {
+	mutation := internal.Mutation{Operation: "reset offsets of consumer-group " + groupName, Destructive: true}
+	return resetConsumerGroupOffset(flags, groupName, mutation)
+}
+
+func resetConsumerGroupOffset(flags ResetConsumerGroupOffsetFlags, groupName string, mutation internal.Mutation) error {
 
-	if (len(flags.Topic) == 0) && (!flags.AllTopics) {
+	var targetOffsets map[string]map[int32]int64
+
+	if flags.FromFile != "" {
+		if len(flags.Topic) > 0 || flags.AllTopics || flags.Partition > -1 {
+			return errors.New("parameters --topic, --all-topics and --partition cannot be used together with --from-file")
+		}
+	} else if (len(flags.Topic) == 0) && (!flags.AllTopics) {
 		return errors.New("no topic specified")
 	}
 
+	if countStrategies(flags) > 1 {
+		return errors.New("parameters --offset, --oldest, --newest, --to-datetime, --by-duration, --shift-by, --to-current and --from-file are exclusive")
+	}
+
+	if flags.ByDuration < 0 {
+		return errors.New("parameter --by-duration must be positive")
+	}
+
+	if flags.FromFile != "" {
+		var err error
+		if targetOffsets, err = readOffsetsFile(flags.FromFile); err != nil {
+			return err
+		}
+	}
+
 	if !flags.Execute {
 		output.Warnf("nothing will be changed (include --execute to perform the reset)")
 	}
@@ -49,6 +82,12 @@ func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags Re
 		return err
 	}
 
+	if flags.Execute {
+		if err = internal.CheckMutation(&ctx, mutation); err != nil {
+			return err
+		}
+	}
+
 	if config, err = internal.CreateClientConfig(&ctx); err != nil {
 		return err
 	}
@@ -63,6 +102,13 @@ func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags Re
 
 	var topics []string
 
+	if targetOffsets != nil {
+		for topic := range targetOffsets {
+			topics = append(topics, topic)
+		}
+		sort.Strings(topics)
+	}
+
 	if flags.AllTopics {
 		// retrieve all topics in the consumerGroup
 		offsets, err := admin.ListConsumerGroupOffsets(groupName, nil)
@@ -73,19 +119,21 @@ func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags Re
 			topics = append(topics, topic)
 		}
 	} else {
+		if targetOffsets == nil {
+			topics = flags.Topic
+		}
+
 		// verify that the provided topics exist
 		existingTopics, err := client.Topics()
 		if err != nil {
 			return errors.Wrap(err, "failed to list available topics")
 		}
 
-		for _, topic := range flags.Topic {
+		for _, topic := range topics {
 			if !util.ContainsString(existingTopics, topic) {
 				return errors.Errorf("topic does not exist: %s", topic)
 			}
 		}
-
-		topics = flags.Topic
 	}
 
 	output.Debugf("reset consumer-group offset for topics: %v", topics)
@@ -124,6 +172,10 @@ func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags Re
 				ready:     make(chan bool),
 			}
 
+			if targetOffsets != nil {
+				consumer.targetOffsets = targetOffsets[topicName]
+			}
+
 			err = consumerGroup.Consume(terminalCtx, []string{topicName}, &consumer)
 			if err != nil {
 				return err
@@ -146,12 +198,25 @@ func (operation *ConsumerGroupOffsetOperation) ResetConsumerGroupOffset(flags Re
 	return nil
 }
 
+func countStrategies(flags ResetConsumerGroupOffsetFlags) int {
+	strategies := []bool{flags.Offset > -1, flags.OldestOffset, flags.NewestOffset, flags.ToDatetime != "",
+		flags.ByDuration != 0, flags.ShiftBy != 0, flags.ToCurrent, flags.FromFile != ""}
+
+	count := 0
+	for _, strategy := range strategies {
+		if strategy {
+			count++
+		}
+	}
+	return count
+}
+
 func (operation *ConsumerGroupOffsetOperation) CreateConsumerGroup(flags ResetConsumerGroupOffsetFlags, group string) error {
 
 	flags.Execute = true
 	flags.OutputFormat = "none"
 
-	err := operation.ResetConsumerGroupOffset(flags, group)
+	err := resetConsumerGroupOffset(flags, group, internal.Mutation{Operation: "create consumer-group " + group})
 	if err != nil {
 		return err
 	}
@@ -177,6 +242,10 @@ func (operation *ConsumerGroupOffsetOperation) CloneConsumerGroup(srcGroup, targ
 		return err
 	}
 
+	if err = internal.CheckMutation(&context, internal.Mutation{Operation: "clone consumer-group " + srcGroup + " to " + targetGroup}); err != nil {
+		return err
+	}
+
 	if config, err = internal.CreateClientConfig(&context); err != nil {
 		return err
 	}
@@ -0,0 +1,123 @@
+package consumergroupoffsets
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fileOffset is a single target offset of an offsets file. The json format matches
+// the output of a reset with `-o json`.
+type fileOffset struct {
+	Topic        string `json:"topic"`
+	Partition    int32  `json:"partition"`
+	TargetOffset *int64 `json:"targetOffset"`
+}
+
+// readOffsetsFile reads target offsets from a csv or json file. Csv files contain lines in the
+// format `topic,partition,offset` like the files used by kafka-consumer-groups.sh.
+func readOffsetsFile(path string) (map[string]map[int32]int64, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open offsets file")
+	}
+	defer file.Close()
+
+	var offsets []fileOffset
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		offsets, err = parseCSVOffsets(file)
+	case ".json":
+		offsets, err = parseJSONOffsets(file)
+	default:
+		return nil, errors.Errorf("unsupported offsets file: %s (expected .csv or .json)", path)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse offsets file %s", path)
+	}
+
+	if len(offsets) == 0 {
+		return nil, errors.Errorf("offsets file %s does not contain offsets", path)
+	}
+
+	targetOffsets := make(map[string]map[int32]int64)
+
+	for _, offset := range offsets {
+		if offset.Topic == "" {
+			return nil, errors.Errorf("offsets file %s contains an entry without topic", path)
+		}
+		if offset.TargetOffset == nil {
+			return nil, errors.Errorf("offsets file %s contains no target offset for topic %s partition %d", path, offset.Topic, offset.Partition)
+		}
+		if targetOffsets[offset.Topic] == nil {
+			targetOffsets[offset.Topic] = make(map[int32]int64)
+		}
+		targetOffsets[offset.Topic][offset.Partition] = *offset.TargetOffset
+	}
+
+	return targetOffsets, nil
+}
+
+func parseCSVOffsets(reader io.Reader) ([]fileOffset, error) {
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make([]fileOffset, 0, len(records))
+
+	for i, record := range records {
+		if i == 0 && record[0] == "topic" {
+			// skip header
+			continue
+		}
+
+		partition, err := strconv.ParseInt(record[1], 10, 32)
+		if err != nil {
+			return nil, errors.Errorf("invalid partition in line %d: %s", i+1, record[1])
+		}
+
+		offset, err := strconv.ParseInt(record[2], 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid offset in line %d: %s", i+1, record[2])
+		}
+
+		offsets = append(offsets, fileOffset{Topic: record[0], Partition: int32(partition), TargetOffset: &offset})
+	}
+
+	return offsets, nil
+}
+
+func parseJSONOffsets(reader io.Reader) ([]fileOffset, error) {
+
+	// a reset of multiple topics prints one json array per topic
+	decoder := json.NewDecoder(reader)
+
+	offsets := make([]fileOffset, 0)
+
+	for {
+		var topicOffsets []fileOffset
+		if err := decoder.Decode(&topicOffsets); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		offsets = append(offsets, topicOffsets...)
+	}
+
+	return offsets, nil
+}
@@ -0,0 +1,68 @@
+package consumergroupoffsets
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeOffsetsFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	return path
+}
+
+func TestReadOffsetsFileCSV(t *testing.T) {
+	path := writeOffsetsFile(t, "offsets.csv", "topic,partition,offset\ntopic-a,0,5\ntopic-a,1,7\ntopic-b,0,3\n")
+
+	offsets, err := readOffsetsFile(path)
+	if err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+
+	expected := map[string]map[int32]int64{"topic-a": {0: 5, 1: 7}, "topic-b": {0: 3}}
+	if !reflect.DeepEqual(offsets, expected) {
+		t.Errorf("Expected %v, got %v", expected, offsets)
+	}
+}
+
+func TestReadOffsetsFileJSON(t *testing.T) {
+	// output of a dry run for two topics
+	content := `[{"topic":"topic-a","Partition":0,"oldestOffset":0,"newestOffset":9,"currentOffset":2,"targetOffset":5}]
+[{"topic":"topic-b","Partition":0,"oldestOffset":0,"newestOffset":9,"currentOffset":2,"targetOffset":3}]`
+	path := writeOffsetsFile(t, "offsets.json", content)
+
+	offsets, err := readOffsetsFile(path)
+	if err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+
+	expected := map[string]map[int32]int64{"topic-a": {0: 5}, "topic-b": {0: 3}}
+	if !reflect.DeepEqual(offsets, expected) {
+		t.Errorf("Expected %v, got %v", expected, offsets)
+	}
+}
+
+func TestReadOffsetsFileWithInvalidContent(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{"offsets.csv", "topic-a,x,5\n"},
+		{"offsets.csv", "topic-a,0\n"},
+		{"offsets.json", `[{"topic":"topic-a","Partition":0}]`},
+		{"offsets.json", `[]`},
+		{"offsets.txt", "topic-a,0,5\n"},
+	}
+
+	for _, tc := range testCases {
+		path := writeOffsetsFile(t, tc.name, tc.content)
+		if _, err := readOffsetsFile(path); err == nil {
+			t.Errorf("Expected error for %s with content %q, got nil", tc.name, tc.content)
+		}
+	}
+}
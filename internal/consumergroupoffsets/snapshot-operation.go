@@ -0,0 +1,454 @@
+package consumergroupoffsets
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/helpers"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v2"
+)
+
+// readTimestampTimeout limits the time waiting for the record at a committed offset.
+const readTimestampTimeout = 5 * time.Second
+
+type ExportConsumerGroupOffsetsFlags struct {
+	File           string
+	WithTimestamps bool
+}
+
+type ImportConsumerGroupOffsetsFlags struct {
+	File                 string
+	Groups               []string
+	TranslateByTimestamp bool
+}
+
+// Snapshot contains the committed offsets of consumer groups.
+type Snapshot struct {
+	CreatedAt      time.Time               `json:"createdAt" yaml:"createdAt"`
+	ConsumerGroups []ConsumerGroupSnapshot `json:"consumerGroups" yaml:"consumerGroups"`
+}
+
+type ConsumerGroupSnapshot struct {
+	Name   string          `json:"name" yaml:"name"`
+	Topics []TopicSnapshot `json:"topics" yaml:"topics"`
+}
+
+type TopicSnapshot struct {
+	Name       string              `json:"name" yaml:"name"`
+	Partitions []PartitionSnapshot `json:"partitions" yaml:"partitions"`
+}
+
+// PartitionSnapshot contains the committed offset of a partition. Timestamp is the timestamp of the
+// record at the committed offset. It is empty if the offset points behind the newest record.
+type PartitionSnapshot struct {
+	Partition int32      `json:"partition" yaml:"partition"`
+	Offset    int64      `json:"offset" yaml:"offset"`
+	Metadata  string     `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+}
+
+func (operation *ConsumerGroupOffsetOperation) ExportConsumerGroupOffsets(groups []string, flags ExportConsumerGroupOffsetsFlags) error {
+
+	var (
+		err     error
+		context internal.ClientContext
+		client  sarama.Client
+		admin   sarama.ClusterAdmin
+	)
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	snapshot := Snapshot{CreatedAt: time.Now().UTC().Truncate(time.Millisecond)}
+
+	for _, group := range groups {
+		groupSnapshot, err := readConsumerGroupSnapshot(client, admin, group, flags.WithTimestamps)
+		if err != nil {
+			return err
+		}
+		snapshot.ConsumerGroups = append(snapshot.ConsumerGroups, groupSnapshot)
+	}
+
+	if flags.File == "" {
+		return output.PrintObject(snapshot, "yaml")
+	}
+
+	content, err := marshalSnapshot(snapshot, flags.File)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(flags.File, content, 0o600); err != nil {
+		return errors.Wrapf(err, "unable to write snapshot file")
+	}
+
+	output.Infof("offsets of %d consumer-groups exported to %s", len(snapshot.ConsumerGroups), flags.File)
+	return nil
+}
+
+func readConsumerGroupSnapshot(client sarama.Client, admin sarama.ClusterAdmin, group string, withTimestamps bool) (ConsumerGroupSnapshot, error) {
+
+	groupSnapshot := ConsumerGroupSnapshot{Name: group}
+
+	offsets, err := admin.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		return groupSnapshot, errors.Wrapf(err, "failed to get consumerGroup '%s' offsets", group)
+	}
+
+	for topic, partitions := range offsets.Blocks {
+		topicSnapshot := TopicSnapshot{Name: topic}
+
+		for partition, block := range partitions {
+			if block.Offset < 0 {
+				continue
+			}
+
+			partitionSnapshot := PartitionSnapshot{Partition: partition, Offset: block.Offset, Metadata: block.Metadata}
+
+			if withTimestamps {
+				if partitionSnapshot.Timestamp, err = readRecordTimestamp(client, topic, partition, block.Offset); err != nil {
+					return groupSnapshot, err
+				}
+			}
+
+			topicSnapshot.Partitions = append(topicSnapshot.Partitions, partitionSnapshot)
+		}
+
+		if len(topicSnapshot.Partitions) == 0 {
+			continue
+		}
+
+		sort.Slice(topicSnapshot.Partitions, func(i, j int) bool {
+			return topicSnapshot.Partitions[i].Partition < topicSnapshot.Partitions[j].Partition
+		})
+
+		groupSnapshot.Topics = append(groupSnapshot.Topics, topicSnapshot)
+	}
+
+	if len(groupSnapshot.Topics) == 0 {
+		return groupSnapshot, errors.Errorf("consumerGroup '%s' does not contain offsets", group)
+	}
+
+	sort.Slice(groupSnapshot.Topics, func(i, j int) bool { return groupSnapshot.Topics[i].Name < groupSnapshot.Topics[j].Name })
+
+	return groupSnapshot, nil
+}
+
+// readRecordTimestamp returns the timestamp of the record at the given offset or nil if there is no such record.
+func readRecordTimestamp(client sarama.Client, topic string, partition int32, offset int64) (*time.Time, error) {
+
+	newestOffset, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get newest offset for topic %s partition %d", topic, partition)
+	}
+
+	oldestOffset, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get oldest offset for topic %s partition %d", topic, partition)
+	}
+
+	if offset >= newestOffset || offset < oldestOffset {
+		return nil, nil
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create consumer")
+	}
+	defer consumer.Close()
+
+	partitionConsumer, err := consumer.ConsumePartition(topic, partition, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to consume topic %s partition %d", topic, partition)
+	}
+	defer partitionConsumer.Close()
+
+	select {
+	case msg := <-partitionConsumer.Messages():
+		timestamp := msg.Timestamp.UTC()
+		return &timestamp, nil
+	case consumerErr := <-partitionConsumer.Errors():
+		return nil, consumerErr
+	case <-time.After(readTimestampTimeout):
+		output.Warnf("timed-out while reading record of topic %s partition %d at offset %d", topic, partition, offset)
+		return nil, nil
+	}
+}
+
+func (operation *ConsumerGroupOffsetOperation) ImportConsumerGroupOffsets(flags ImportConsumerGroupOffsetsFlags) error {
+
+	var (
+		err          error
+		context      internal.ClientContext
+		config       *sarama.Config
+		client       sarama.Client
+		admin        sarama.ClusterAdmin
+		descriptions []*sarama.GroupDescription
+	)
+
+	snapshot, err := readSnapshot(flags.File)
+	if err != nil {
+		return err
+	}
+
+	groups := make([]ConsumerGroupSnapshot, 0, len(snapshot.ConsumerGroups))
+	for _, group := range snapshot.ConsumerGroups {
+		if len(flags.Groups) == 0 || util.ContainsString(flags.Groups, group.Name) {
+			groups = append(groups, group)
+		}
+	}
+
+	for _, group := range flags.Groups {
+		if !containsGroup(snapshot.ConsumerGroups, group) {
+			return errors.Errorf("snapshot does not contain consumerGroup '%s'", group)
+		}
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if config, err = internal.CreateClientConfig(&context); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	groupNames := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupNames = append(groupNames, group.Name)
+	}
+
+	if descriptions, err = admin.DescribeConsumerGroups(groupNames); err != nil {
+		return errors.Wrap(err, "failed to describe consumer groups")
+	}
+
+	for _, description := range descriptions {
+		if description.State != "Empty" && description.State != "Dead" {
+			return errors.Errorf("cannot import offsets of consumer group %s. There are consumers assigned (state: %s)", description.GroupId, description.State)
+		}
+	}
+
+	// compute all offsets first, so that nothing is changed when an offset is invalid
+	groupOffsets := make(map[string]map[string]map[int32]PartitionOffset) // group->topic->partition->offset
+
+	for _, group := range groups {
+		if groupOffsets[group.Name], err = targetOffsetsOfGroup(client, group, snapshot.CreatedAt, flags.TranslateByTimestamp); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		mutation := internal.Mutation{Operation: "import offsets of consumer-group " + group.Name, Destructive: true}
+		if err := internal.CheckMutation(&context, mutation); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		if err := setConsumerGroupOffsets(context, config, group.Name, groupOffsets[group.Name]); err != nil {
+			return err
+		}
+		output.Infof("consumer-group offsets imported: %s", group.Name)
+	}
+
+	return nil
+}
+
+func targetOffsetsOfGroup(client sarama.Client, group ConsumerGroupSnapshot, createdAt time.Time, translateByTimestamp bool) (map[string]map[int32]PartitionOffset, error) {
+
+	existingTopics, err := client.Topics()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list available topics")
+	}
+
+	topicOffsets := make(map[string]map[int32]PartitionOffset)
+
+	for _, topic := range group.Topics {
+		if !util.ContainsString(existingTopics, topic.Name) {
+			return nil, errors.Errorf("topic does not exist: %s", topic.Name)
+		}
+
+		partitionOffsets := make(map[int32]PartitionOffset)
+
+		for _, partition := range topic.Partitions {
+			offset, err := targetOffset(client, group.Name, topic.Name, partition, createdAt, translateByTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			partitionOffsets[partition.Partition] = PartitionOffset{Offset: offset, Metadata: partition.Metadata}
+		}
+
+		topicOffsets[topic.Name] = partitionOffsets
+	}
+
+	return topicOffsets, nil
+}
+
+func targetOffset(client sarama.Client, group, topic string, partition PartitionSnapshot, createdAt time.Time, translateByTimestamp bool) (int64, error) {
+
+	oldestOffset, err := client.GetOffset(topic, partition.Partition, sarama.OffsetOldest)
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to get oldest offset for topic %s partition %d", topic, partition.Partition)
+	}
+
+	newestOffset, err := client.GetOffset(topic, partition.Partition, sarama.OffsetNewest)
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to get newest offset for topic %s partition %d", topic, partition.Partition)
+	}
+
+	if !translateByTimestamp {
+		if partition.Offset < oldestOffset || partition.Offset > newestOffset {
+			return -1, errors.Errorf("offset %d of consumerGroup '%s' is out of range for topic %s partition %d (%d-%d). Use --translate-by-timestamp if the topic was recreated",
+				partition.Offset, group, topic, partition.Partition, oldestOffset, newestOffset)
+		}
+		return partition.Offset, nil
+	}
+
+	// an offset without timestamp pointed behind the newest record, i.e. the group
+	// had consumed all records produced before the snapshot was created
+	timestamp := createdAt
+	if partition.Timestamp != nil {
+		timestamp = *partition.Timestamp
+	}
+
+	offset, err := client.GetOffset(topic, partition.Partition, timestamp.UnixMilli())
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to get offset for timestamp on topic %s partition %d", topic, partition.Partition)
+	}
+
+	if offset == sarama.OffsetNewest {
+		offset = newestOffset
+	}
+
+	output.Debugf("translated offset %d of topic %s partition %d to %d", partition.Offset, topic, partition.Partition, offset)
+
+	return offset, nil
+}
+
+func setConsumerGroupOffsets(context internal.ClientContext, config *sarama.Config, group string, topicPartitionOffsets map[string]map[int32]PartitionOffset) error {
+
+	consumerGroup, err := sarama.NewConsumerGroup(context.Brokers, group, config)
+	if err != nil {
+		return errors.Errorf("failed to create consumer group %s: %v", group, err)
+	}
+
+	terminalCtx := helpers.CreateTerminalContext()
+
+	consumeErrorGroup, _ := errgroup.WithContext(terminalCtx)
+	consumeErrorGroup.SetLimit(100)
+
+	for topic, partitionOffsets := range topicPartitionOffsets {
+		topicName, offsets := topic, partitionOffsets
+		consumeErrorGroup.Go(func() error {
+			consumer := OffsetSettingConsumer{
+				Topic:            topicName,
+				PartitionOffsets: offsets,
+				Rewind:           true,
+			}
+
+			if err := consumerGroup.Consume(terminalCtx, []string{topicName}, &consumer); err != nil {
+				return err
+			}
+			<-consumer.ready
+			return nil
+		})
+	}
+
+	if err := consumeErrorGroup.Wait(); err != nil {
+		return err
+	}
+
+	return consumerGroup.Close()
+}
+
+func containsGroup(groups []ConsumerGroupSnapshot, name string) bool {
+	for _, group := range groups {
+		if group.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSONFile(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".json"
+}
+
+func marshalSnapshot(snapshot Snapshot, path string) ([]byte, error) {
+	if isJSONFile(path) {
+		content, err := json.MarshalIndent(snapshot, "", "\t")
+		return content, errors.Wrap(err, "unable to format json")
+	}
+	content, err := yaml.Marshal(snapshot)
+	return content, errors.Wrap(err, "unable to format yaml")
+}
+
+func readSnapshot(path string) (Snapshot, error) {
+
+	var snapshot Snapshot
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return snapshot, errors.Wrap(err, "unable to read snapshot file")
+	}
+
+	if isJSONFile(path) {
+		err = json.Unmarshal(content, &snapshot)
+	} else {
+		err = yaml.Unmarshal(content, &snapshot)
+	}
+
+	if err != nil {
+		return snapshot, errors.Wrapf(err, "unable to parse snapshot file %s", path)
+	}
+
+	if len(snapshot.ConsumerGroups) == 0 {
+		return snapshot, errors.Errorf("snapshot file %s does not contain consumer groups", path)
+	}
+
+	for _, group := range snapshot.ConsumerGroups {
+		if group.Name == "" {
+			return snapshot, errors.Errorf("snapshot file %s contains a consumer group without name", path)
+		}
+		for _, topic := range group.Topics {
+			for _, partition := range topic.Partitions {
+				if partition.Offset < 0 {
+					return snapshot, errors.Errorf("snapshot file %s contains invalid offset %d for group %s topic %s partition %d",
+						path, partition.Offset, group.Name, topic.Name, partition.Partition)
+				}
+			}
+		}
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,78 @@
+package consumergroupoffsets
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	timestamp := time.Date(2026, 10, 1, 12, 0, 0, 123000000, time.UTC)
+
+	snapshot := Snapshot{
+		CreatedAt: time.Date(2026, 10, 2, 8, 30, 0, 0, time.UTC),
+		ConsumerGroups: []ConsumerGroupSnapshot{{
+			Name: "my-group",
+			Topics: []TopicSnapshot{{
+				Name: "my-topic",
+				Partitions: []PartitionSnapshot{
+					{Partition: 0, Offset: 5, Timestamp: &timestamp},
+					{Partition: 1, Offset: 7, Metadata: "meta"},
+				},
+			}},
+		}},
+	}
+
+	for _, name := range []string{"snapshot.yaml", "snapshot.json"} {
+		path := filepath.Join(t.TempDir(), name)
+
+		content, err := marshalSnapshot(snapshot, path)
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+
+		if err := os.WriteFile(path, content, 0o600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+
+		read, err := readSnapshot(path)
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+
+		if !read.CreatedAt.Equal(snapshot.CreatedAt) {
+			t.Errorf("%s: expected createdAt %v, got %v", name, snapshot.CreatedAt, read.CreatedAt)
+		}
+
+		partitions := read.ConsumerGroups[0].Topics[0].Partitions
+		if partitions[0].Timestamp == nil || !partitions[0].Timestamp.Equal(timestamp) {
+			t.Errorf("%s: expected timestamp %v, got %v", name, timestamp, partitions[0].Timestamp)
+		}
+		partitions[0].Timestamp = nil
+
+		expected := []PartitionSnapshot{{Partition: 0, Offset: 5}, {Partition: 1, Offset: 7, Metadata: "meta"}}
+		if !reflect.DeepEqual(partitions, expected) {
+			t.Errorf("%s: expected %v, got %v", name, expected, partitions)
+		}
+	}
+}
+
+func TestReadSnapshotWithInvalidContent(t *testing.T) {
+	testCases := []string{
+		"consumerGroups: []",
+		"consumerGroups:\n- topics: []",
+		"consumerGroups:\n- name: g\n  topics:\n  - name: t\n    partitions:\n    - partition: 0\n      offset: -1",
+	}
+
+	for _, content := range testCases {
+		path := filepath.Join(t.TempDir(), "snapshot.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		if _, err := readSnapshot(path); err == nil {
+			t.Errorf("Expected error for %q, got nil", content)
+		}
+	}
+}
@@ -20,9 +20,9 @@ type consumerGroup struct {
 	Topics       []string
 }
 
-type topicPartitionOffsets struct {
+type TopicPartitionOffsets struct {
 	Name       string
-	Partitions []partitionOffset `json:",omitempty" yaml:",omitempty"`
+	Partitions []PartitionOffset `json:",omitempty" yaml:",omitempty"`
 	TotalLag   int64             `json:"totalLag" yaml:"totalLag"`
 }
 
@@ -31,7 +31,7 @@ type topicPartition struct {
 	Partitions []int32 `json:"," yaml:",flow"`
 }
 
-type partitionOffset struct {
+type PartitionOffset struct {
 	Partition      int32
 	NewestOffset   int64 `json:"newestOffset" yaml:"newestOffset"`
 	OldestOffset   int64 `json:"oldestOffset" yaml:"oldestOffset"`
@@ -51,7 +51,7 @@ type consumerGroupDescription struct {
 	Group    consumerGroup
 	Protocol string
 	State    string
-	Topics   []topicPartitionOffsets `json:",omitempty" yaml:",omitempty"`
+	Topics   []TopicPartitionOffsets `json:",omitempty" yaml:",omitempty"`
 	Members  []consumerGroupMember   `json:",omitempty" yaml:",omitempty"`
 }
 
@@ -231,7 +231,36 @@ func (operation *ConsumerGroupOperation) DescribeConsumerGroup(flags DescribeCon
 	return nil
 }
 
-func filterAssignedPartitions(assignedPartitions map[string][]int32, topicPartitions []topicPartitionOffsets) map[string][]int32 {
+// ReadConsumerGroupOffsets reads the committed offsets and the lag of a consumer group for all its topics.
+func ReadConsumerGroupOffsets(client sarama.Client, admin sarama.ClusterAdmin, group string) ([]TopicPartitionOffsets, error) {
+
+	offsets, err := admin.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list consumer-group offsets")
+	}
+
+	return createTopicPartitions(offsets, client, DescribeConsumerGroupFlags{})
+}
+
+// ListConsumerGroupNames returns the sorted names of all consumer groups.
+func ListConsumerGroupNames(admin sarama.ClusterAdmin) ([]string, error) {
+
+	// groups is a map from groupName to protocolType
+	groups, err := admin.ListConsumerGroups()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list consumer groups")
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for k := range groups {
+		groupNames = append(groupNames, k)
+	}
+	sort.Strings(groupNames)
+
+	return groupNames, nil
+}
+
+func filterAssignedPartitions(assignedPartitions map[string][]int32, topicPartitions []TopicPartitionOffsets) map[string][]int32 {
 
 	result := make(map[string][]int32)
 
@@ -277,9 +306,9 @@ func addMember(members []consumerGroupMember, clientHost string, clientID string
 	return append(members, member)
 }
 
-func createTopicPartitions(offsets *sarama.OffsetFetchResponse, client sarama.Client, flags DescribeConsumerGroupFlags) ([]topicPartitionOffsets, error) {
+func createTopicPartitions(offsets *sarama.OffsetFetchResponse, client sarama.Client, flags DescribeConsumerGroupFlags) ([]TopicPartitionOffsets, error) {
 
-	topicPartitionList := make([]topicPartitionOffsets, 0)
+	topicPartitionList := make([]TopicPartitionOffsets, 0)
 
 	var topicsSorted []string
 
@@ -293,11 +322,11 @@ func createTopicPartitions(offsets *sarama.OffsetFetchResponse, client sarama.Cl
 	for _, topic := range topicsSorted {
 		if topic != "" {
 
-			details := make([]partitionOffset, 0, len(offsets.Blocks[topic]))
+			details := make([]PartitionOffset, 0, len(offsets.Blocks[topic]))
 
 			var totalLag int64
 
-			partitionChannel := make(chan partitionOffset)
+			partitionChannel := make(chan PartitionOffset)
 			errChannel := make(chan error)
 
 			for partition := range offsets.Blocks[topic] {
@@ -320,10 +349,10 @@ func createTopicPartitions(offsets *sarama.OffsetFetchResponse, client sarama.Cl
 					lag := newestOffset - offsets.Blocks[topic][partition].Offset
 
 					if !flags.OnlyPartitionsWithLag || lag > 0 {
-						partitionChannel <- partitionOffset{Partition: partition, NewestOffset: newestOffset, OldestOffset: oldestOffset,
+						partitionChannel <- PartitionOffset{Partition: partition, NewestOffset: newestOffset, OldestOffset: oldestOffset,
 							ConsumerOffset: offsets.Blocks[topic][partition].Offset, Lead: lead, Lag: lag}
 					} else {
-						partitionChannel <- partitionOffset{Partition: -1}
+						partitionChannel <- PartitionOffset{Partition: -1}
 					}
 				}(partition)
 			}
@@ -344,7 +373,7 @@ func createTopicPartitions(offsets *sarama.OffsetFetchResponse, client sarama.Cl
 				return details[i].Partition < details[j].Partition
 			})
 
-			topicPartitions := topicPartitionOffsets{Name: topic, Partitions: details, TotalLag: totalLag}
+			topicPartitions := TopicPartitionOffsets{Name: topic, Partitions: details, TotalLag: totalLag}
 			topicPartitionList = append(topicPartitionList, topicPartitions)
 		}
 	}
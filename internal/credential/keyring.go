@@ -99,3 +99,12 @@ func (r *KeyringResolver) Flush() error {
 	r.pending = nil
 	return nil
 }
+
+// StorePassword saves a password in the OS keyring, e.g. after it has been rotated.
+func StorePassword(fieldName, value string) error {
+	if err := keyring.Set(KeyringService, fieldName, value); err != nil {
+		return fmt.Errorf("failed to save to keyring: %w", err)
+	}
+	output.Debugf("saved keyring entry: %s", fieldName)
+	return nil
+}
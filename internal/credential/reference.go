@@ -0,0 +1,191 @@
+package credential
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+)
+
+// ReferenceResolver resolves a secret that is referenced in the config instead of being stored in plain text.
+type ReferenceResolver interface {
+	Resolve(reference string) (string, error)
+}
+
+type referenceResolverFunc func(reference string) (string, error)
+
+func (f referenceResolverFunc) Resolve(reference string) (string, error) {
+	return f(reference)
+}
+
+// referenceResolvers maps the prefix of a reference to its resolver. The prefix is passed to the resolver.
+var referenceResolvers = map[string]ReferenceResolver{
+	"vault:": &VaultResolver{},
+	"op://":  referenceResolverFunc(resolveOnePassword),
+	"exec:":  referenceResolverFunc(resolveExec),
+	"env:":   referenceResolverFunc(resolveEnv),
+	"file:":  referenceResolverFunc(resolveFile),
+}
+
+// IsReference returns true if the value references a secret, e.g. vault:secret/kafka#password.
+func IsReference(value string) bool {
+	for prefix := range referenceResolvers {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveReference resolves references like vault:secret/kafka#password, op://vault/item/field, exec:command,
+// env:VARIABLE or file:path. Values that are no reference are returned as they are.
+func ResolveReference(value string) (string, error) {
+	for prefix, resolver := range referenceResolvers {
+		if strings.HasPrefix(value, prefix) {
+			output.Debugf("resolving credential reference: %s", value)
+			return resolver.Resolve(value)
+		}
+	}
+	return value, nil
+}
+
+func resolveEnv(reference string) (string, error) {
+	name := strings.TrimPrefix(reference, "env:")
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+func resolveFile(reference string) (string, error) {
+	path := strings.TrimPrefix(reference, "file:")
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve home dir: %w", err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read credential file: %w", err)
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+func resolveExec(reference string) (string, error) {
+	command := strings.TrimPrefix(reference, "exec:")
+	if runtime.GOOS == "windows" {
+		return runCommand("cmd", "/C", command)
+	}
+	return runCommand("sh", "-c", command)
+}
+
+func resolveOnePassword(reference string) (string, error) {
+	return runCommand("op", "read", "--no-newline", reference)
+}
+
+func runCommand(name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("credential command %q failed: %w %s", strings.Join(append([]string{name}, args...), " "), err,
+			strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
+
+// VaultResolver reads a field of a secret from HashiCorp Vault: vault:<path>#<field>.
+// The address and token are taken from VAULT_ADDR and VAULT_TOKEN (or ~/.vault-token) like the vault cli does.
+// Secrets of kv version 1 and 2 engines are supported, for kv version 2 the path has to contain /data/.
+type VaultResolver struct {
+	client *http.Client
+}
+
+func (r *VaultResolver) Resolve(reference string) (string, error) {
+
+	path, field, ok := strings.Cut(strings.TrimPrefix(reference, "vault:"), "#")
+	if !ok || path == "" || field == "" {
+		return "", fmt.Errorf("vault reference has to be in the format vault:<path>#<field>: %s", reference)
+	}
+
+	address := os.Getenv("VAULT_ADDR")
+	if address == "" {
+		return "", fmt.Errorf("VAULT_ADDR has to be set to resolve %s", reference)
+	}
+
+	token, err := vaultToken()
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(address, "/")+"/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid vault request: %w", err)
+	}
+	request.Header.Set("X-Vault-Token", token)
+	if namespace := os.Getenv("VAULT_NAMESPACE"); namespace != "" {
+		request.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	client := r.client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s from vault: %w", path, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to read secret %s from vault: %s", path, response.Status)
+	}
+
+	var secret struct {
+		Data map[string]any `json:"data"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("failed to parse secret %s from vault: %w", path, err)
+	}
+
+	data := secret.Data
+	// kv version 2 wraps the secret in data.data
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, isMetadata := data["metadata"]; isMetadata {
+			data = nested
+		}
+	}
+
+	value, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("secret %s in vault has no field %s", path, field)
+	}
+	return fmt.Sprint(value), nil
+}
+
+func vaultToken() (string, error) {
+	if token := os.Getenv("VAULT_TOKEN"); token != "" {
+		return token, nil
+	}
+	home, err := os.UserHomeDir()
+	if err == nil {
+		if token, err := os.ReadFile(filepath.Join(home, ".vault-token")); err == nil {
+			return strings.TrimSpace(string(token)), nil
+		}
+	}
+	return "", fmt.Errorf("VAULT_TOKEN has to be set or a token has to be stored in ~/.vault-token")
+}
@@ -0,0 +1,123 @@
+package credential
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func newVaultStub(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/secret/data/kafka":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"kv2-secret"},"metadata":{"version":1}}}`))
+		case "/v1/kv/kafka":
+			_, _ = w.Write([]byte(`{"data":{"password":"kv1-secret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+	return server
+}
+
+func TestResolveReference_Vault(t *testing.T) {
+	newVaultStub(t)
+
+	for reference, want := range map[string]string{
+		"vault:secret/data/kafka#password": "kv2-secret",
+		"vault:kv/kafka#password":          "kv1-secret",
+	} {
+		value, err := ResolveReference(reference)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", reference, err)
+		}
+		if value != want {
+			t.Errorf("expected %q for %s, got %q", want, reference, value)
+		}
+	}
+}
+
+func TestResolveReference_VaultErrors(t *testing.T) {
+	newVaultStub(t)
+
+	for reference, wantErr := range map[string]string{
+		"vault:secret/data/kafka":          "vault:<path>#<field>",
+		"vault:secret/data/kafka#username": "has no field username",
+		"vault:secret/data/other#password": "404",
+	} {
+		_, err := ResolveReference(reference)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("expected error containing %q for %s, got %v", wantErr, reference, err)
+		}
+	}
+
+	t.Setenv("VAULT_TOKEN", "wrong-token")
+	if _, err := ResolveReference("vault:kv/kafka#password"); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("expected forbidden error, got %v", err)
+	}
+}
+
+func TestResolveReference_Env(t *testing.T) {
+	t.Setenv("KAFKACTL_TEST_SECRET", "env-secret")
+
+	value, err := ResolveReference("env:KAFKACTL_TEST_SECRET")
+	if err != nil || value != "env-secret" {
+		t.Fatalf("expected env-secret, got %q (%v)", value, err)
+	}
+
+	if _, err = ResolveReference("env:KAFKACTL_TEST_UNDEFINED"); err == nil {
+		t.Fatal("expected error for undefined environment variable")
+	}
+}
+
+func TestResolveReference_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(path, []byte("file-secret\n"), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	value, err := ResolveReference("file:" + path)
+	if err != nil || value != "file-secret" {
+		t.Fatalf("expected file-secret, got %q (%v)", value, err)
+	}
+}
+
+func TestResolveReference_Exec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a posix shell")
+	}
+
+	value, err := ResolveReference("exec:echo exec-secret")
+	if err != nil || value != "exec-secret" {
+		t.Fatalf("expected exec-secret, got %q (%v)", value, err)
+	}
+
+	if _, err = ResolveReference("exec:exit 1"); err == nil {
+		t.Fatal("expected error for failing command")
+	}
+}
+
+func TestResolveReference_PlainValue(t *testing.T) {
+	value, err := ResolveReference("plain-password")
+	if err != nil || value != "plain-password" {
+		t.Fatalf("expected value to be returned unchanged, got %q (%v)", value, err)
+	}
+	if IsReference("plain-password") || !IsReference("vault:kv/kafka#password") {
+		t.Fatal("unexpected result of IsReference")
+	}
+}
@@ -0,0 +1,101 @@
+package delegationtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/kerberos"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+// tokenClient is a franz-go client that is only used for the delegation token apis, which are not supported by
+// sarama. All other requests use the sarama client.
+type tokenClient struct {
+	*kgo.Client
+	timeout time.Duration
+}
+
+// newTokenClient creates the client with TLS and SASL of the sarama config of the context. The kafka version of the
+// context is not applied, because franz-go negotiates the request versions with the broker and creating tokens for
+// other owners requires a newer version of the request than the default kafka version.
+func newTokenClient(clientContext *internal.ClientContext) (*tokenClient, error) {
+
+	config, err := internal.CreateClientConfig(clientContext)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []kgo.Opt{
+		kgo.SeedBrokers(clientContext.Brokers...),
+		kgo.ClientID(config.ClientID),
+	}
+
+	if config.Net.TLS.Enable {
+		options = append(options, kgo.DialTLSConfig(config.Net.TLS.Config))
+	}
+
+	if config.Net.SASL.Enable {
+		mechanism, err := saslMechanism(clientContext, config)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, kgo.SASL(mechanism))
+	}
+
+	client, err := kgo.NewClient(options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create client")
+	}
+	return &tokenClient{Client: client, timeout: config.Admin.Timeout}, nil
+}
+
+// requestContext limits a request including its retries to the admin request timeout of the context.
+func (client *tokenClient) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), client.timeout)
+}
+
+func saslMechanism(clientContext *internal.ClientContext, config *sarama.Config) (sasl.Mechanism, error) {
+
+	user := config.Net.SASL.User
+	password := config.Net.SASL.Password
+
+	switch config.Net.SASL.Mechanism {
+	case sarama.SASLTypePlaintext, "":
+		return plain.Auth{User: user, Pass: password}.AsMechanism(), nil
+	case sarama.SASLTypeSCRAMSHA256:
+		return scram.Auth{User: user, Pass: password, IsToken: clientContext.Sasl.TokenAuth}.AsSha256Mechanism(), nil
+	case sarama.SASLTypeSCRAMSHA512:
+		return scram.Auth{User: user, Pass: password, IsToken: clientContext.Sasl.TokenAuth}.AsSha512Mechanism(), nil
+	case sarama.SASLTypeOAuth:
+		tokenProvider := config.Net.SASL.TokenProvider
+		return oauth.Oauth(func(context.Context) (oauth.Auth, error) {
+			token, err := tokenProvider.Token()
+			if err != nil {
+				return oauth.Auth{}, err
+			}
+			return oauth.Auth{Token: token.Token, Extensions: token.Extensions}, nil
+		}), nil
+	case sarama.SASLTypeGSSAPI:
+		gssapiConfig := config.Net.SASL.GSSAPI
+		return kerberos.Kerberos(func(context.Context) (kerberos.Auth, error) {
+			kerberosClient, err := sarama.NewKerberosClient(&gssapiConfig)
+			if err != nil {
+				return kerberos.Auth{}, errors.Wrap(err, "failed to create kerberos client")
+			}
+			goKrb5Client, ok := kerberosClient.(*sarama.KerberosGoKrb5Client)
+			if !ok {
+				return kerberos.Auth{}, errors.Errorf("unsupported kerberos client: %T", kerberosClient)
+			}
+			return kerberos.Auth{Client: &goKrb5Client.Client, Service: gssapiConfig.ServiceName}, nil
+		}), nil
+	default:
+		return nil, errors.Errorf("Unknown sasl mechanism: %s", config.Net.SASL.Mechanism)
+	}
+}
@@ -0,0 +1,42 @@
+package delegationtoken
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+)
+
+func TestSaslMechanism(t *testing.T) {
+
+	testCases := []struct {
+		mechanism string
+		want      string
+	}{
+		{mechanism: "", want: "PLAIN"},
+		{mechanism: sarama.SASLTypePlaintext, want: "PLAIN"},
+		{mechanism: sarama.SASLTypeSCRAMSHA256, want: "SCRAM-SHA-256"},
+		{mechanism: sarama.SASLTypeSCRAMSHA512, want: "SCRAM-SHA-512"},
+		{mechanism: sarama.SASLTypeOAuth, want: "OAUTHBEARER"},
+		{mechanism: sarama.SASLTypeGSSAPI, want: "GSSAPI"},
+	}
+
+	for _, tc := range testCases {
+		config := sarama.NewConfig()
+		config.Net.SASL.Mechanism = sarama.SASLMechanism(tc.mechanism)
+
+		mechanism, err := saslMechanism(&internal.ClientContext{}, config)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", tc.mechanism, err)
+		}
+		if mechanism.Name() != tc.want {
+			t.Fatalf("expected %s, got %s", tc.want, mechanism.Name())
+		}
+	}
+
+	config := sarama.NewConfig()
+	config.Net.SASL.Mechanism = "unknown"
+	if _, err := saslMechanism(&internal.ClientContext{}, config); err == nil {
+		t.Fatal("expected an error for an unknown mechanism")
+	}
+}
@@ -0,0 +1,348 @@
+package delegationtoken
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/credential"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// DelegationToken describes a delegation token. The hmac is base64 encoded.
+type DelegationToken struct {
+	TokenID    string    `json:"tokenId" yaml:"tokenId"`
+	HMAC       string    `json:"hmac" yaml:"hmac"`
+	Owner      string    `json:"owner" yaml:"owner"`
+	Requester  string    `json:"requester,omitempty" yaml:"requester,omitempty"`
+	Renewers   []string  `json:"renewers,omitempty" yaml:"renewers,omitempty"`
+	IssueTime  time.Time `json:"issueTime" yaml:"issueTime"`
+	ExpiryTime time.Time `json:"expiryTime" yaml:"expiryTime"`
+	MaxTime    time.Time `json:"maxTime" yaml:"maxTime"`
+}
+
+type CreateDelegationTokenFlags struct {
+	MaxLifetime  time.Duration
+	Renewers     []string
+	Owner        string
+	OutputFormat string
+}
+
+type GetDelegationTokensFlags struct {
+	Owners       []string
+	OutputFormat string
+}
+
+type RenewDelegationTokenFlags struct {
+	HMAC        string
+	RenewPeriod time.Duration
+}
+
+type ExpireDelegationTokenFlags struct {
+	HMAC         string
+	ExpiryPeriod time.Duration
+}
+
+type Operation struct{}
+
+// CreateDelegationToken creates a delegation token for the authenticated user or the given owner. Without
+// --max-lifetime, the max lifetime configured on the broker is used.
+func (operation *Operation) CreateDelegationToken(flags CreateDelegationTokenFlags) error {
+
+	if err := validateOutputFormat(flags.OutputFormat); err != nil {
+		return err
+	}
+
+	request := kmsg.NewPtrCreateDelegationTokenRequest()
+	request.MaxLifetimeMillis = durationMillis(flags.MaxLifetime)
+
+	renewers := make([]string, 0, len(flags.Renewers))
+	for _, renewer := range flags.Renewers {
+		principalType, principalName, err := parsePrincipal(renewer)
+		if err != nil {
+			return err
+		}
+		renewers = append(renewers, principal(principalType, principalName))
+		tokenRenewer := kmsg.NewCreateDelegationTokenRequestRenewer()
+		tokenRenewer.PrincipalType = principalType
+		tokenRenewer.PrincipalName = principalName
+		request.Renewers = append(request.Renewers, tokenRenewer)
+	}
+
+	if flags.Owner != "" {
+		principalType, principalName, err := parsePrincipal(flags.Owner)
+		if err != nil {
+			return err
+		}
+		request.OwnerPrincipalType = &principalType
+		request.OwnerPrincipalName = &principalName
+	}
+
+	client, err := createClient(&internal.Mutation{Operation: "create delegation token"})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := client.requestContext()
+	defer cancel()
+
+	response, err := request.RequestWith(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "failed to create delegation token")
+	}
+	if err = kerr.ErrorForCode(response.ErrorCode); err != nil {
+		return errors.Wrap(err, "failed to create delegation token")
+	}
+
+	token := DelegationToken{
+		TokenID:    response.TokenID,
+		HMAC:       base64.StdEncoding.EncodeToString(response.HMAC),
+		Owner:      principal(response.PrincipalType, response.PrincipalName),
+		Requester:  principal(response.TokenRequesterPrincipalType, response.TokenRequesterPrincipalName),
+		Renewers:   renewers,
+		IssueTime:  time.UnixMilli(response.IssueTimestamp),
+		ExpiryTime: time.UnixMilli(response.ExpiryTimestamp),
+		MaxTime:    time.UnixMilli(response.MaxTimestamp),
+	}
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(token, flags.OutputFormat)
+	}
+
+	tableWriter := output.CreateTableWriter()
+	if err := tableWriter.WriteHeader("TOKEN_ID", "HMAC", "OWNER", "EXPIRY_TIME", "MAX_TIME"); err != nil {
+		return err
+	}
+	if err := tableWriter.Write(token.TokenID, token.HMAC, token.Owner, formatTime(token.ExpiryTime), formatTime(token.MaxTime)); err != nil {
+		return err
+	}
+	return tableWriter.Flush()
+}
+
+// GetDelegationTokens lists the delegation tokens the authenticated user is allowed to describe, optionally
+// restricted to the given owners.
+func (operation *Operation) GetDelegationTokens(flags GetDelegationTokensFlags) error {
+
+	if err := validateOutputFormat(flags.OutputFormat); err != nil {
+		return err
+	}
+
+	request := kmsg.NewPtrDescribeDelegationTokenRequest()
+
+	// without owners all tokens are described, an empty list would describe none
+	if len(flags.Owners) > 0 {
+		request.Owners = make([]kmsg.DescribeDelegationTokenRequestOwner, 0, len(flags.Owners))
+		for _, owner := range flags.Owners {
+			principalType, principalName, err := parsePrincipal(owner)
+			if err != nil {
+				return err
+			}
+			tokenOwner := kmsg.NewDescribeDelegationTokenRequestOwner()
+			tokenOwner.PrincipalType = principalType
+			tokenOwner.PrincipalName = principalName
+			request.Owners = append(request.Owners, tokenOwner)
+		}
+	}
+
+	client, err := createClient(nil)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := client.requestContext()
+	defer cancel()
+
+	response, err := request.RequestWith(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "failed to get delegation tokens")
+	}
+	if err = kerr.ErrorForCode(response.ErrorCode); err != nil {
+		return errors.Wrap(err, "failed to get delegation tokens")
+	}
+
+	tokens := make([]DelegationToken, 0, len(response.TokenDetails))
+	for _, detail := range response.TokenDetails {
+		token := DelegationToken{
+			TokenID:    detail.TokenID,
+			HMAC:       base64.StdEncoding.EncodeToString(detail.HMAC),
+			Owner:      principal(detail.PrincipalType, detail.PrincipalName),
+			Requester:  principal(detail.TokenRequesterPrincipalType, detail.TokenRequesterPrincipalName),
+			IssueTime:  time.UnixMilli(detail.IssueTimestamp),
+			ExpiryTime: time.UnixMilli(detail.ExpiryTimestamp),
+			MaxTime:    time.UnixMilli(detail.MaxTimestamp),
+		}
+		for _, renewer := range detail.Renewers {
+			token.Renewers = append(token.Renewers, principal(renewer.PrincipalType, renewer.PrincipalName))
+		}
+		tokens = append(tokens, token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].TokenID < tokens[j].TokenID
+	})
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(tokens, flags.OutputFormat)
+	}
+
+	// the hmac is a secret and only printed with json or yaml output
+	tableWriter := output.CreateTableWriter()
+	if err := tableWriter.WriteHeader("TOKEN_ID", "OWNER", "RENEWERS", "ISSUE_TIME", "EXPIRY_TIME", "MAX_TIME"); err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := tableWriter.Write(token.TokenID, token.Owner, strings.Join(token.Renewers, ","),
+			formatTime(token.IssueTime), formatTime(token.ExpiryTime), formatTime(token.MaxTime)); err != nil {
+			return err
+		}
+	}
+	return tableWriter.Flush()
+}
+
+// RenewDelegationToken extends the expiry time of a token by the renew period. Without --renew-period, the expiry
+// time configured on the broker is used. The expiry time never exceeds the max lifetime of the token.
+func (operation *Operation) RenewDelegationToken(flags RenewDelegationTokenFlags) error {
+
+	hmac, err := decodeHMAC(flags.HMAC)
+	if err != nil {
+		return err
+	}
+
+	request := kmsg.NewPtrRenewDelegationTokenRequest()
+	request.HMAC = hmac
+	request.RenewTimeMillis = durationMillis(flags.RenewPeriod)
+
+	client, err := createClient(&internal.Mutation{Operation: "renew delegation token"})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := client.requestContext()
+	defer cancel()
+
+	response, err := request.RequestWith(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "failed to renew delegation token")
+	}
+	if err = kerr.ErrorForCode(response.ErrorCode); err != nil {
+		return errors.Wrap(err, "failed to renew delegation token")
+	}
+
+	output.Infof("delegation token has been renewed, it expires at %s", formatTime(time.UnixMilli(response.ExpiryTimestamp)))
+	return nil
+}
+
+// ExpireDelegationToken expires a token immediately or after the given expiry period.
+func (operation *Operation) ExpireDelegationToken(flags ExpireDelegationTokenFlags) error {
+
+	hmac, err := decodeHMAC(flags.HMAC)
+	if err != nil {
+		return err
+	}
+
+	request := kmsg.NewPtrExpireDelegationTokenRequest()
+	request.HMAC = hmac
+	// a negative period expires the token immediately
+	request.ExpiryPeriodMillis = durationMillis(flags.ExpiryPeriod)
+
+	client, err := createClient(&internal.Mutation{Operation: "expire delegation token", Destructive: true})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := client.requestContext()
+	defer cancel()
+
+	response, err := request.RequestWith(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "failed to expire delegation token")
+	}
+	if err = kerr.ErrorForCode(response.ErrorCode); err != nil {
+		return errors.Wrap(err, "failed to expire delegation token")
+	}
+
+	if flags.ExpiryPeriod > 0 {
+		output.Infof("delegation token expires at %s", formatTime(time.UnixMilli(response.ExpiryTimestamp)))
+	} else {
+		output.Infof("delegation token has been expired")
+	}
+	return nil
+}
+
+// createClient creates the client after the mutation, if any, has been checked against the protection config of
+// the context.
+func createClient(mutation *internal.Mutation) (*tokenClient, error) {
+
+	clientContext, err := internal.CreateClientContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if mutation != nil {
+		if err = internal.CheckMutation(&clientContext, *mutation); err != nil {
+			return nil, err
+		}
+	}
+
+	return newTokenClient(&clientContext)
+}
+
+func validateOutputFormat(outputFormat string) error {
+	if outputFormat != "" && outputFormat != "json" && outputFormat != "yaml" {
+		return errors.Errorf("unknown output format: %s", outputFormat)
+	}
+	return nil
+}
+
+// decodeHMAC decodes the base64 encoded hmac. The hmac may be given as credential reference, e.g. env:TOKEN_HMAC
+func decodeHMAC(value string) ([]byte, error) {
+	resolved, err := credential.ResolveReference(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to resolve hmac")
+	}
+	hmac, err := base64.StdEncoding.DecodeString(resolved)
+	if err != nil || len(hmac) == 0 {
+		return nil, errors.New("hmac has to be base64 encoded")
+	}
+	return hmac, nil
+}
+
+// durationMillis converts the duration to milliseconds. Kafka uses the broker default for -1.
+func durationMillis(duration time.Duration) int64 {
+	if duration <= 0 {
+		return -1
+	}
+	return duration.Milliseconds()
+}
+
+// parsePrincipal splits a principal like User:alice into type and name. The type defaults to User.
+func parsePrincipal(value string) (string, string, error) {
+	principalType, principalName, found := strings.Cut(value, ":")
+	if !found {
+		return "User", value, nil
+	}
+	if principalType == "" || principalName == "" {
+		return "", "", errors.Errorf("invalid principal: %s (expected format: User:name)", value)
+	}
+	return principalType, principalName, nil
+}
+
+func principal(principalType, principalName string) string {
+	if principalName == "" {
+		return ""
+	}
+	return principalType + ":" + principalName
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
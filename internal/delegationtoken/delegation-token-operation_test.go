@@ -0,0 +1,73 @@
+package delegationtoken
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestParsePrincipal(t *testing.T) {
+
+	testCases := []struct {
+		value         string
+		principalType string
+		principalName string
+		wantErr       bool
+	}{
+		{value: "User:alice", principalType: "User", principalName: "alice"},
+		{value: "alice", principalType: "User", principalName: "alice"},
+		{value: "Group:admins", principalType: "Group", principalName: "admins"},
+		{value: "User:", wantErr: true},
+		{value: ":alice", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			principalType, principalName, err := parsePrincipal(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %s", tc.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if principalType != tc.principalType || principalName != tc.principalName {
+				t.Fatalf("expected %s:%s, got %s:%s", tc.principalType, tc.principalName, principalType, principalName)
+			}
+		})
+	}
+}
+
+func TestDecodeHMAC(t *testing.T) {
+
+	t.Setenv("KAFKACTL_TEST_TOKEN_HMAC", "aG1hYw==")
+
+	for _, value := range []string{"aG1hYw==", "env:KAFKACTL_TEST_TOKEN_HMAC"} {
+		hmac, err := decodeHMAC(value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(hmac, []byte("hmac")) {
+			t.Fatalf("expected hmac, got %s", hmac)
+		}
+	}
+
+	if _, err := decodeHMAC("not base64!"); err == nil {
+		t.Fatal("expected an error for an invalid hmac")
+	}
+	if _, err := decodeHMAC("env:KAFKACTL_TEST_UNDEFINED"); err == nil {
+		t.Fatal("expected an error for an unresolvable reference")
+	}
+}
+
+func TestDurationMillis(t *testing.T) {
+
+	if millis := durationMillis(0); millis != -1 {
+		t.Fatalf("expected -1 for the broker default, got %d", millis)
+	}
+	if millis := durationMillis(2 * time.Hour); millis != 7200000 {
+		t.Fatalf("expected 7200000, got %d", millis)
+	}
+}
@@ -0,0 +1,77 @@
+package global
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// UpdateContextConfig replaces the value of a key of a context (e.g. sasl.password) in the config file.
+// The key has to exist in the file already. Comments and formatting of the file are kept.
+func UpdateContextConfig(contextName, key, value string) (string, error) {
+
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return "", fmt.Errorf("no config file in use")
+	}
+
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return configFile, fmt.Errorf("unable to read config file: %w", err)
+	}
+
+	var document yaml.Node
+	if err = yaml.Unmarshal(content, &document); err != nil {
+		return configFile, fmt.Errorf("unable to parse config file %s: %w", configFile, err)
+	}
+
+	if len(document.Content) == 0 {
+		return configFile, fmt.Errorf("config file %s is empty", configFile)
+	}
+
+	node := document.Content[0]
+	for _, name := range append([]string{"contexts", contextName}, strings.Split(key, ".")...) {
+		if node = mappingValue(node, name); node == nil {
+			return configFile, fmt.Errorf("contexts.%s.%s is not defined in config file %s", contextName, key, configFile)
+		}
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return configFile, fmt.Errorf("contexts.%s.%s in config file %s is not a value", contextName, key, configFile)
+	}
+	node.Value = value
+	node.Tag = "!!str"
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&document); err != nil {
+		return configFile, fmt.Errorf("unable to write config file: %w", err)
+	}
+
+	info, err := os.Stat(configFile)
+	if err != nil {
+		return configFile, fmt.Errorf("unable to write config file: %w", err)
+	}
+	if err = os.WriteFile(configFile, buffer.Bytes(), info.Mode().Perm()); err != nil {
+		return configFile, fmt.Errorf("unable to write config file: %w", err)
+	}
+	return configFile, nil
+}
+
+// mappingValue returns the value of a key in a yaml mapping. Keys are compared case-insensitive like viper does.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -16,10 +16,11 @@ import (
 )
 
 type Flags struct {
-	ConfigFile   string
-	Context      string
-	Verbose      bool
-	ClearKeyring bool
+	ConfigFile       string
+	Context          string
+	Verbose          bool
+	ClearKeyring     bool
+	SkipConfirmation bool
 }
 
 const defaultContextPrefix = "CONTEXTS_DEFAULT_"
@@ -83,3 +83,41 @@ func TestResolvePath(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateContextConfig(t *testing.T) {
+
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	content := `contexts:
+  default:
+    brokers:
+      - localhost:9092
+    sasl:
+      enabled: true
+      username: admin
+      # password of the admin user
+      password: old-secret
+`
+	if err := os.WriteFile(configFile, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	viper.Reset()
+	viper.SetConfigFile(configFile)
+	defer viper.Reset()
+
+	if _, err := global.UpdateContextConfig("default", "sasl.password", "new-secret"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	testutil.AssertContainSubstring(t, "password: new-secret", string(updated))
+	testutil.AssertContainSubstring(t, "# password of the admin user", string(updated))
+	testutil.AssertContainNoSubstring(t, "old-secret", string(updated))
+
+	_, err = global.UpdateContextConfig("default", "tls.certKeyPassphrase", "secret")
+	testutil.AssertErrorContains(t, "contexts.default.tls.certKeyPassphrase is not defined in config file", err)
+}
@@ -15,6 +15,18 @@ const (
 	SaslMechanism                      = "SASL_MECHANISM"
 	SaslTokenProviderPlugin            = "SASL_TOKENPROVIDER_PLUGIN"
 	SaslTokenProviderOptions           = "SASL_TOKENPROVIDER_OPTIONS"
+	SaslTokenAuth                      = "SASL_TOKENAUTH"
+	SaslAWSRegion                      = "SASL_AWS_REGION"
+	SaslAWSProfile                     = "SASL_AWS_PROFILE"
+	SaslAWSRoleArn                     = "SASL_AWS_ROLEARN"
+	SaslAWSRoleSessionName             = "SASL_AWS_ROLESESSIONNAME"
+	SaslKerberosAuthType               = "SASL_KERBEROS_AUTHTYPE"
+	SaslKerberosKeytabPath             = "SASL_KERBEROS_KEYTABPATH"
+	SaslKerberosCCachePath             = "SASL_KERBEROS_CCACHEPATH"
+	SaslKerberosConfigPath             = "SASL_KERBEROS_CONFIGPATH"
+	SaslKerberosRealm                  = "SASL_KERBEROS_REALM"
+	SaslKerberosServiceName            = "SASL_KERBEROS_SERVICENAME"
+	SaslKerberosDisablePAFXFAST        = "SASL_KERBEROS_DISABLEPAFXFAST"
 	ClientID                           = "CLIENTID"
 	KafkaVersion                       = "KAFKAVERSION"
 	AvroJSONCodec                      = "AVRO_JSONCODEC"
@@ -34,6 +46,9 @@ const (
 	ProducerPartitioner                = "PRODUCER_PARTITIONER"
 	ProducerRequiredAcks               = "PRODUCER_REQUIREDACKS"
 	ProducerMaxMessageBytes            = "PRODUCER_MAXMESSAGEBYTES"
+	ProtectionReadOnly                 = "PROTECTION_READONLY"
+	ProtectionProtectedTopics          = "PROTECTION_PROTECTEDTOPICS"
+	ProtectionRequireConfirmation      = "PROTECTION_REQUIRECONFIRMATION"
 )
 
 var EnvVariables = []string{
@@ -51,6 +66,18 @@ var EnvVariables = []string{
 	SaslMechanism,
 	SaslTokenProviderPlugin,
 	SaslTokenProviderOptions,
+	SaslTokenAuth,
+	SaslAWSRegion,
+	SaslAWSProfile,
+	SaslAWSRoleArn,
+	SaslAWSRoleSessionName,
+	SaslKerberosAuthType,
+	SaslKerberosKeytabPath,
+	SaslKerberosCCachePath,
+	SaslKerberosConfigPath,
+	SaslKerberosRealm,
+	SaslKerberosServiceName,
+	SaslKerberosDisablePAFXFAST,
 	ClientID,
 	KafkaVersion,
 	AvroJSONCodec,
@@ -70,4 +97,7 @@ var EnvVariables = []string{
 	ProducerPartitioner,
 	ProducerRequiredAcks,
 	ProducerMaxMessageBytes,
+	ProtectionReadOnly,
+	ProtectionProtectedTopics,
+	ProtectionRequireConfirmation,
 }
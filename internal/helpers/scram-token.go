@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xdg-go/scram"
+)
+
+// SCRAMTokenClient authenticates with a delegation token (token id as username, hmac as password).
+// Kafka requires the SCRAM extension tokenauth=true for delegation tokens, which is not supported
+// by github.com/xdg-go/scram, so the conversation is implemented here.
+type SCRAMTokenClient struct {
+	scram.HashGeneratorFcn
+	tokenID         string
+	hmac            string
+	nonce           string
+	clientFirstBare string
+	serverSignature []byte
+	step            int
+	done            bool
+}
+
+func (x *SCRAMTokenClient) Begin(tokenID, hmac, _ string) error {
+	nonce := make([]byte, 24)
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.Wrap(err, "failed to generate nonce")
+	}
+	x.tokenID = tokenID
+	x.hmac = hmac
+	x.nonce = base64.StdEncoding.EncodeToString(nonce)
+	x.step = 0
+	x.done = false
+	return nil
+}
+
+func (x *SCRAMTokenClient) Step(challenge string) (string, error) {
+	defer func() { x.step++ }()
+
+	switch x.step {
+	case 0:
+		x.clientFirstBare = "n=" + escapeSaslName(x.tokenID) + ",r=" + x.nonce + ",tokenauth=true"
+		return "n,," + x.clientFirstBare, nil
+	case 1:
+		return x.clientFinal(challenge)
+	case 2:
+		x.done = true
+		return "", x.verifyServerFinal(challenge)
+	default:
+		return "", errors.New("unexpected scram challenge after authentication completed")
+	}
+}
+
+func (x *SCRAMTokenClient) Done() bool {
+	return x.done
+}
+
+func (x *SCRAMTokenClient) clientFinal(serverFirst string) (string, error) {
+	attributes := parseScramAttributes(serverFirst)
+
+	nonce := attributes["r"]
+	if !strings.HasPrefix(nonce, x.nonce) {
+		return "", errors.New("server nonce does not start with client nonce")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(attributes["s"])
+	if err != nil {
+		return "", errors.Wrap(err, "invalid salt in server-first-message")
+	}
+
+	iterations, err := strconv.Atoi(attributes["i"])
+	if err != nil || iterations < 1 {
+		return "", errors.Errorf("invalid iteration count in server-first-message: %s", attributes["i"])
+	}
+
+	saltedPassword, err := pbkdf2.Key(x.HashGeneratorFcn, x.hmac, salt, iterations, x.HashGeneratorFcn().Size())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to derive salted password")
+	}
+
+	clientFinalWithoutProof := "c=biws,r=" + nonce
+	authMessage := []byte(x.clientFirstBare + "," + serverFirst + "," + clientFinalWithoutProof)
+
+	clientKey := x.computeHMAC(saltedPassword, []byte("Client Key"))
+	storedKey := x.HashGeneratorFcn()
+	storedKey.Write(clientKey)
+	clientSignature := x.computeHMAC(storedKey.Sum(nil), authMessage)
+
+	proof := make([]byte, len(clientKey))
+	subtle.XORBytes(proof, clientKey, clientSignature)
+
+	serverKey := x.computeHMAC(saltedPassword, []byte("Server Key"))
+	x.serverSignature = x.computeHMAC(serverKey, authMessage)
+
+	return clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof), nil
+}
+
+func (x *SCRAMTokenClient) verifyServerFinal(serverFinal string) error {
+	attributes := parseScramAttributes(serverFinal)
+
+	if serverError, ok := attributes["e"]; ok {
+		return errors.Errorf("server error: %s", serverError)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(attributes["v"])
+	if err != nil {
+		return errors.Wrap(err, "invalid server signature")
+	}
+	if !hmac.Equal(signature, x.serverSignature) {
+		return errors.New("server signature does not match")
+	}
+	return nil
+}
+
+func (x *SCRAMTokenClient) computeHMAC(key, message []byte) []byte {
+	mac := hmac.New(x.HashGeneratorFcn, key)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+func parseScramAttributes(message string) map[string]string {
+	attributes := make(map[string]string)
+	for _, field := range strings.Split(message, ",") {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			attributes[key] = value
+		}
+	}
+	return attributes
+}
+
+func escapeSaslName(name string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(name)
+}
@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xdg-go/scram"
+)
+
+func newTokenServer(t *testing.T, tokenID, hmac string) *scram.ServerConversation {
+	t.Helper()
+
+	client, err := SHA512.NewClient(tokenID, hmac, "")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	credentials := client.GetStoredCredentials(scram.KeyFactors{Salt: "salt", Iters: 4096})
+
+	server, err := SHA512.NewServer(func(string) (scram.StoredCredentials, error) {
+		return credentials, nil
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	return server.NewConversation()
+}
+
+func TestSCRAMTokenClientAuthenticates(t *testing.T) {
+
+	server := newTokenServer(t, "token-id", "token-hmac")
+	client := &SCRAMTokenClient{HashGeneratorFcn: SHA512}
+
+	if err := client.Begin("token-id", "token-hmac", ""); err != nil {
+		t.Fatalf("failed to begin: %v", err)
+	}
+
+	challenge := ""
+	for !client.Done() {
+		response, err := client.Step(challenge)
+		if err != nil {
+			t.Fatalf("client step failed: %v", err)
+		}
+		if client.Done() {
+			break
+		}
+		if strings.HasPrefix(response, "n,,") && !strings.HasSuffix(response, ",tokenauth=true") {
+			t.Fatalf("client-first-message does not contain tokenauth extension: %s", response)
+		}
+		challenge, err = server.Step(response)
+		if err != nil {
+			t.Fatalf("server step failed: %v", err)
+		}
+	}
+
+	if !server.Valid() {
+		t.Fatalf("expected authentication to be valid")
+	}
+}
+
+func TestSCRAMTokenClientFailsWithWrongHmac(t *testing.T) {
+
+	server := newTokenServer(t, "token-id", "token-hmac")
+	client := &SCRAMTokenClient{HashGeneratorFcn: SHA512}
+
+	if err := client.Begin("token-id", "wrong-hmac", ""); err != nil {
+		t.Fatalf("failed to begin: %v", err)
+	}
+
+	clientFirst, _ := client.Step("")
+	serverFirst, err := server.Step(clientFirst)
+	if err != nil {
+		t.Fatalf("server step failed: %v", err)
+	}
+	clientFinal, err := client.Step(serverFirst)
+	if err != nil {
+		t.Fatalf("client step failed: %v", err)
+	}
+	serverFinal, _ := server.Step(clientFinal)
+
+	if _, err := client.Step(serverFinal); err == nil {
+		t.Fatalf("expected authentication to fail")
+	}
+}
+
+func TestEscapeSaslName(t *testing.T) {
+	if escaped := escapeSaslName("a=b,c"); escaped != "a=3Db=2Cc" {
+		t.Fatalf("unexpected escaped name: %s", escaped)
+	}
+}
@@ -182,6 +182,80 @@ func TestExecWithTLSSecretProvided(t *testing.T) {
 	}
 }
 
+func TestExecWithTLSAndKeytabSecretProvided(t *testing.T) {
+	var clientContext internal.ClientContext
+	clientContext.Kubernetes.Image = "private.registry.com/deviceinsight/kafkactl"
+	clientContext.Kubernetes.TLSSecret = "my-tls-secret"
+	clientContext.Kubernetes.KeytabSecret = "my-keytab-secret"
+
+	testRunner := TestRunner{}
+	testRunner.response = []byte(sampleKubectlVersionOutput)
+	var runner k8s.Runner = &testRunner
+
+	exec, err := k8s.NewExecutor(context.Background(), clientContext, runner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = exec.Run("scratch", "/kafkactl", []string{"version"}, []string{"ENV_A=1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overrides := extractParam(t, testRunner.args, "--overrides")
+	var podOverrides k8s.JSONPatchType
+	if err := json.Unmarshal([]byte(overrides), &podOverrides); err != nil {
+		t.Fatalf("unable to unmarshall overrides: %v", err)
+	}
+
+	volumePatches := 0
+	foundVolumeMounts := false
+
+	for _, patch := range podOverrides {
+		if patch.Path == "/spec/volumes" {
+			volumePatches++
+			valueBytes, _ := json.Marshal(patch.Value)
+			var volumes []struct {
+				Name   string `json:"name"`
+				Secret struct {
+					SecretName string `json:"secretName"`
+				} `json:"secret"`
+			}
+			if err := json.Unmarshal(valueBytes, &volumes); err != nil {
+				t.Fatalf("unable to parse volumes value: %v", err)
+			}
+			if len(volumes) != 2 || volumes[0].Secret.SecretName != "my-tls-secret" ||
+				volumes[1].Name != "kafkactl-keytab" || volumes[1].Secret.SecretName != "my-keytab-secret" {
+				t.Fatalf("wrong volumes in patch: %s", overrides)
+			}
+		}
+
+		if patch.Path == "/spec/containers/0/volumeMounts" {
+			foundVolumeMounts = true
+			valueBytes, _ := json.Marshal(patch.Value)
+			var volumeMounts []struct {
+				Name      string `json:"name"`
+				MountPath string `json:"mountPath"`
+				ReadOnly  bool   `json:"readOnly"`
+			}
+			if err := json.Unmarshal(valueBytes, &volumeMounts); err != nil {
+				t.Fatalf("unable to parse volumeMounts value: %v", err)
+			}
+			if len(volumeMounts) != 2 || volumeMounts[1].Name != "kafkactl-keytab" ||
+				volumeMounts[1].MountPath != "/etc/kafkactl/kerberos" || !volumeMounts[1].ReadOnly {
+				t.Fatalf("wrong volumeMounts in patch: %s", overrides)
+			}
+		}
+	}
+
+	if volumePatches != 1 {
+		t.Fatalf("expected exactly one volumes patch operation in overrides: %s", overrides)
+	}
+	if !foundVolumeMounts {
+		t.Fatalf("volumeMounts patch operation not found in overrides: %s", overrides)
+	}
+}
+
 func TestExecWithResourcesProvided(t *testing.T) {
 	var clientContext internal.ClientContext
 	clientContext.Kubernetes.Image = "private.registry.com/deviceinsight/kafkactl"
@@ -28,6 +28,7 @@ type executor struct {
 	image            string
 	imagePullSecret  string
 	tlsSecret        string
+	keytabSecret     string
 	saslSecretName   string
 	createSaslSecret bool
 	saslSecret       string
@@ -115,6 +116,7 @@ func newExecutor(ctx context.Context, clientContext internal.ClientContext, runn
 		image:            clientContext.Kubernetes.Image,
 		imagePullSecret:  clientContext.Kubernetes.ImagePullSecret,
 		tlsSecret:        clientContext.Kubernetes.TLSSecret,
+		keytabSecret:     clientContext.Kubernetes.KeytabSecret,
 		saslSecretName:   clientContext.Kubernetes.SaslSecret.Name,
 		createSaslSecret: clientContext.Kubernetes.SaslSecret.Create,
 		saslConfig:       clientContext.Sasl,
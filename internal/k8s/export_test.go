@@ -5,3 +5,5 @@ var ParsePodEnvironment = parsePodEnvironment
 var GetKubectlVersion = getKubectlVersion
 
 var NewExecutor = newExecutor
+
+var ValidateAWSMskIam = validateAWSMskIam
@@ -44,6 +44,25 @@ func (op *operation) initialize(context internal.ClientContext) error {
 		return errors.Errorf("context has no kubernetes namespace set: contexts.%s.kubernetes.namespace", context.Name)
 	}
 
+	return validateAWSMskIam(context)
+}
+
+// validateAWSMskIam checks the aws-msk-iam config for the pod. Local AWS credentials and profile files are not
+// available in the pod, which has to get its credentials from the service account (IRSA) or the node role.
+func validateAWSMskIam(context internal.ClientContext) error {
+	if !context.Sasl.Enabled || context.Sasl.Mechanism != "aws-msk-iam" {
+		return nil
+	}
+
+	if context.Sasl.AWS.Profile != "" {
+		return errors.Errorf("sasl.aws.profile is not supported when running in kubernetes, the pod has to use "+
+			"IAM roles for service accounts (IRSA): contexts.%s.sasl.aws.profile", context.Name)
+	}
+
+	if !context.TLS.Enabled {
+		return errors.Errorf("sasl mechanism aws-msk-iam requires tls: contexts.%s.tls.enabled", context.Name)
+	}
+
 	return nil
 }
 
@@ -195,6 +214,18 @@ func parsePodEnvironment(context internal.ClientContext) []string {
 	envVariables = appendStringIfDefined(envVariables, global.SaslMechanism, context.Sasl.Mechanism)
 	envVariables = appendStringIfDefined(envVariables, global.SaslTokenProviderPlugin, context.Sasl.TokenProvider.PluginName)
 	envVariables = appendMapIfDefined(envVariables, global.SaslTokenProviderOptions, context.Sasl.TokenProvider.Options)
+	envVariables = appendBool(envVariables, global.SaslTokenAuth, context.Sasl.TokenAuth)
+	envVariables = appendStringIfDefined(envVariables, global.SaslAWSRegion, context.Sasl.AWS.Region)
+	envVariables = appendStringIfDefined(envVariables, global.SaslAWSProfile, context.Sasl.AWS.Profile)
+	envVariables = appendStringIfDefined(envVariables, global.SaslAWSRoleArn, context.Sasl.AWS.RoleArn)
+	envVariables = appendStringIfDefined(envVariables, global.SaslAWSRoleSessionName, context.Sasl.AWS.RoleSessionName)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosAuthType, context.Sasl.Kerberos.AuthType)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosKeytabPath, context.Sasl.Kerberos.KeytabPath)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosCCachePath, context.Sasl.Kerberos.CCachePath)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosConfigPath, context.Sasl.Kerberos.ConfigPath)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosRealm, context.Sasl.Kerberos.Realm)
+	envVariables = appendStringIfDefined(envVariables, global.SaslKerberosServiceName, context.Sasl.Kerberos.ServiceName)
+	envVariables = appendBool(envVariables, global.SaslKerberosDisablePAFXFAST, context.Sasl.Kerberos.DisablePAFXFAST)
 	envVariables = appendStringIfDefined(envVariables, global.RequestTimeout, context.RequestTimeout.String())
 	envVariables = appendStringIfDefined(envVariables, global.ClientID, context.ClientID)
 	envVariables = appendStringIfDefined(envVariables, global.KafkaVersion, context.KafkaVersion.String())
@@ -215,6 +246,9 @@ func parsePodEnvironment(context internal.ClientContext) []string {
 	envVariables = appendStringIfDefined(envVariables, global.ProducerPartitioner, context.Producer.Partitioner)
 	envVariables = appendStringIfDefined(envVariables, global.ProducerRequiredAcks, context.Producer.RequiredAcks)
 	envVariables = appendIntIfGreaterZero(envVariables, global.ProducerMaxMessageBytes, context.Producer.MaxMessageBytes)
+	envVariables = appendBool(envVariables, global.ProtectionReadOnly, context.Protection.ReadOnly)
+	envVariables = appendStrings(envVariables, global.ProtectionProtectedTopics, context.Protection.ProtectedTopics)
+	envVariables = appendBool(envVariables, global.ProtectionRequireConfirmation, context.Protection.RequireConfirmation)
 
 	return envVariables
 }
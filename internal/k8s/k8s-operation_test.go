@@ -34,6 +34,18 @@ func TestAllAvailableEnvironmentVariablesAreParsed(t *testing.T) {
 	context.Sasl.TokenProvider.Options = make(map[string]any)
 	context.Sasl.TokenProvider.Options["tenantid"] = "azure-tenant-id"
 	context.Sasl.TokenProvider.Options["int-key"] = 12
+	context.Sasl.TokenAuth = true
+	context.Sasl.AWS.Region = "eu-central-1"
+	context.Sasl.AWS.Profile = "msk"
+	context.Sasl.AWS.RoleArn = "arn:aws:iam::123456789012:role/kafka"
+	context.Sasl.AWS.RoleSessionName = "session"
+	context.Sasl.Kerberos.AuthType = "keytab"
+	context.Sasl.Kerberos.KeytabPath = "kafka.keytab"
+	context.Sasl.Kerberos.CCachePath = "/tmp/krb5cc_1000"
+	context.Sasl.Kerberos.ConfigPath = "krb5.conf"
+	context.Sasl.Kerberos.Realm = "EXAMPLE.COM"
+	context.Sasl.Kerberos.ServiceName = "kafka"
+	context.Sasl.Kerberos.DisablePAFXFAST = true
 	context.ClientID = "my-client"
 	context.KafkaVersion = sarama.V2_0_1_0
 	context.Avro.JSONCodec = avro.Avro
@@ -53,6 +65,9 @@ func TestAllAvailableEnvironmentVariablesAreParsed(t *testing.T) {
 	context.Producer.Partitioner = "hash"
 	context.Producer.RequiredAcks = "WaitForAll"
 	context.Producer.MaxMessageBytes = 1234
+	context.Protection.ReadOnly = true
+	context.Protection.ProtectedTopics = []string{"orders", "payments-*"}
+	context.Protection.RequireConfirmation = true
 
 	environment := k8s.ParsePodEnvironment(context)
 
@@ -82,6 +97,18 @@ func TestAllAvailableEnvironmentVariablesAreParsed(t *testing.T) {
 	testutil.AssertEquals(t, "oauth", envMap[global.SaslMechanism])
 	testutil.AssertEquals(t, "azure", envMap[global.SaslTokenProviderPlugin])
 	testutil.AssertEquals(t, `{"int-key":12,"tenantid":"azure-tenant-id"}`, envMap[global.SaslTokenProviderOptions])
+	testutil.AssertEquals(t, "true", envMap[global.SaslTokenAuth])
+	testutil.AssertEquals(t, "eu-central-1", envMap[global.SaslAWSRegion])
+	testutil.AssertEquals(t, "msk", envMap[global.SaslAWSProfile])
+	testutil.AssertEquals(t, "arn:aws:iam::123456789012:role/kafka", envMap[global.SaslAWSRoleArn])
+	testutil.AssertEquals(t, "session", envMap[global.SaslAWSRoleSessionName])
+	testutil.AssertEquals(t, "keytab", envMap[global.SaslKerberosAuthType])
+	testutil.AssertEquals(t, "kafka.keytab", envMap[global.SaslKerberosKeytabPath])
+	testutil.AssertEquals(t, "/tmp/krb5cc_1000", envMap[global.SaslKerberosCCachePath])
+	testutil.AssertEquals(t, "krb5.conf", envMap[global.SaslKerberosConfigPath])
+	testutil.AssertEquals(t, "EXAMPLE.COM", envMap[global.SaslKerberosRealm])
+	testutil.AssertEquals(t, "kafka", envMap[global.SaslKerberosServiceName])
+	testutil.AssertEquals(t, "true", envMap[global.SaslKerberosDisablePAFXFAST])
 	testutil.AssertEquals(t, "my-client", envMap[global.ClientID])
 	testutil.AssertEquals(t, "2.0.1", envMap[global.KafkaVersion])
 	testutil.AssertEquals(t, "avro", envMap[global.AvroJSONCodec])
@@ -100,6 +127,9 @@ func TestAllAvailableEnvironmentVariablesAreParsed(t *testing.T) {
 	testutil.AssertEquals(t, "hash", envMap[global.ProducerPartitioner])
 	testutil.AssertEquals(t, "WaitForAll", envMap[global.ProducerRequiredAcks])
 	testutil.AssertEquals(t, "1234", envMap[global.ProducerMaxMessageBytes])
+	testutil.AssertEquals(t, "true", envMap[global.ProtectionReadOnly])
+	testutil.AssertEquals(t, "orders payments-*", envMap[global.ProtectionProtectedTopics])
+	testutil.AssertEquals(t, "true", envMap[global.ProtectionRequireConfirmation])
 }
 
 func TestSaslCredentialsNotInPodEnvironmentWhenSaslSecretNameIsSet(t *testing.T) {
@@ -139,3 +169,21 @@ func TestSaslCredentialsNotInPodEnvironmentWhenSaslSecretCreateIsEnabled(t *test
 		}
 	}
 }
+
+func TestValidateAWSMskIam(t *testing.T) {
+	var context internal.ClientContext
+	context.Name = "msk"
+	context.Sasl.Enabled = true
+	context.Sasl.Mechanism = "aws-msk-iam"
+	context.Sasl.AWS.Region = "eu-central-1"
+
+	testutil.AssertErrorContains(t, "aws-msk-iam requires tls", k8s.ValidateAWSMskIam(context))
+
+	context.TLS.Enabled = true
+	if err := k8s.ValidateAWSMskIam(context); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	context.Sasl.AWS.Profile = "msk"
+	testutil.AssertErrorContains(t, "sasl.aws.profile is not supported when running in kubernetes", k8s.ValidateAWSMskIam(context))
+}
@@ -59,21 +59,31 @@ func (kubectl *executor) createPodOverrides() JSONPatchType {
 		})
 	}
 
+	var volumes []volumeType
+	var volumeMounts []volumeMountType
+
 	// mount tls secret if specified
 	if kubectl.tlsSecret != "" {
+		volumes = append(volumes, volumeType{Name: "kafkactl-tls", Secret: secretType{SecretName: kubectl.tlsSecret}})
+		volumeMounts = append(volumeMounts, volumeMountType{Name: "kafkactl-tls", MountPath: "/etc/ssl/certs/kafkactl", ReadOnly: true})
+	}
+
+	// mount kerberos keytab secret if specified
+	if kubectl.keytabSecret != "" {
+		volumes = append(volumes, volumeType{Name: "kafkactl-keytab", Secret: secretType{SecretName: kubectl.keytabSecret}})
+		volumeMounts = append(volumeMounts, volumeMountType{Name: "kafkactl-keytab", MountPath: "/etc/kafkactl/kerberos", ReadOnly: true})
+	}
+
+	if len(volumes) > 0 {
 		patches = append(patches, JSONPatchOperation{
-			Op:   "add",
-			Path: "/spec/volumes",
-			Value: []volumeType{
-				{Name: "kafkactl-tls", Secret: secretType{SecretName: kubectl.tlsSecret}},
-			},
+			Op:    "add",
+			Path:  "/spec/volumes",
+			Value: volumes,
 		})
 		patches = append(patches, JSONPatchOperation{
-			Op:   "add",
-			Path: "/spec/containers/0/volumeMounts",
-			Value: []volumeMountType{
-				{Name: "kafkactl-tls", MountPath: "/etc/ssl/certs/kafkactl", ReadOnly: true},
-			},
+			Op:    "add",
+			Path:  "/spec/containers/0/volumeMounts",
+			Value: volumeMounts,
 		})
 	}
 
@@ -0,0 +1,51 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+// IsInteractive returns true if the input stream is a terminal.
+func IsInteractive() bool {
+	file, ok := IoStreams.In.(*os.File)
+	return ok && file != nil && term.IsTerminal(int(file.Fd()))
+}
+
+// Confirm asks the user a yes/no question. An error is returned if the input is no terminal,
+// so that commands never block in scripts. These should use --yes instead.
+func Confirm(question string) (bool, error) {
+	if !IsInteractive() {
+		return false, errors.New("confirmation required but no terminal available (use --yes to skip the confirmation)")
+	}
+
+	_, _ = fmt.Fprintf(IoStreams.ErrOut, "%s [y/N]: ", question)
+
+	answer, err := bufio.NewReader(IoStreams.In).ReadString('\n')
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read confirmation")
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// ConfirmByTyping asks the user to type the expected text, e.g. the name of a resource that is about to be deleted.
+func ConfirmByTyping(question, expected string) (bool, error) {
+	if !IsInteractive() {
+		return false, errors.New("confirmation required but no terminal available (use --yes to skip the confirmation)")
+	}
+
+	_, _ = fmt.Fprintf(IoStreams.ErrOut, "%s\ntype '%s' to confirm: ", question, expected)
+
+	answer, err := bufio.NewReader(IoStreams.In).ReadString('\n')
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read confirmation")
+	}
+
+	return strings.TrimSpace(answer) == expected, nil
+}
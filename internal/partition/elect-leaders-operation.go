@@ -0,0 +1,224 @@
+package partition
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+type ElectLeadersFlags struct {
+	Topics       []string
+	Partitions   []int32
+	Type         string
+	All          bool
+	DryRun       bool
+	OutputFormat string
+}
+
+type leaderElection struct {
+	Topic     string
+	Partition int32
+	Leader    int32
+	Replicas  []int32 `yaml:",flow"`
+	Result    string  `json:",omitempty" yaml:",omitempty"`
+}
+
+const noLeader = -1
+
+// ElectLeaders triggers a preferred or unclean leader election for all partitions that need one.
+// A preferred election is needed if the leader is not the first replica, an unclean election if there is no leader.
+func (operation *Operation) ElectLeaders(flags ElectLeadersFlags) error {
+
+	var (
+		context      internal.ClientContext
+		client       sarama.Client
+		admin        sarama.ClusterAdmin
+		electionType sarama.ElectionType
+		err          error
+	)
+
+	switch flags.Type {
+	case "", "preferred":
+		electionType = sarama.PreferredElection
+	case "unclean":
+		electionType = sarama.UncleanElection
+	default:
+		return errors.Errorf("unknown election type: %s (preferred|unclean)", flags.Type)
+	}
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if flags.All == (len(flags.Topics) > 0) {
+		return errors.New("either --topic or --all is required")
+	}
+
+	if len(flags.Partitions) > 0 && len(flags.Topics) != 1 {
+		return errors.New("--partition requires exactly one --topic")
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	topics, err := topic.SelectTopics(client, flags.Topics, topic.SelectTopicsFlags{})
+	if err != nil {
+		return err
+	}
+
+	metadata, err := admin.DescribeTopics(topics)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe topics")
+	}
+
+	elections, err := electionCandidates(metadata, electionType, flags.Partitions)
+	if err != nil {
+		return err
+	}
+
+	if len(elections) == 0 {
+		output.Infof("no partitions need a leader election")
+		return nil
+	}
+
+	if !flags.DryRun {
+		partitions := make(map[string][]int32)
+		for _, election := range elections {
+			partitions[election.Topic] = append(partitions[election.Topic], election.Partition)
+		}
+
+		results, err := admin.ElectLeaders(electionType, partitions)
+		if err != nil {
+			return errors.Wrap(err, "failed to elect leaders")
+		}
+
+		for i, election := range elections {
+			elections[i].Result = electionResult(results[election.Topic][election.Partition])
+		}
+	}
+
+	if flags.OutputFormat != "" {
+		if err := output.PrintObject(elections, flags.OutputFormat); err != nil {
+			return err
+		}
+	} else if err := printLeaderElections(elections, !flags.DryRun); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, election := range elections {
+		if election.Result != "" && election.Result != "elected" && election.Result != "not needed" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return errors.Errorf("leader election failed for %d partitions", failed)
+	}
+	return nil
+}
+
+func electionCandidates(metadata []*sarama.TopicMetadata, electionType sarama.ElectionType, partitions []int32) ([]leaderElection, error) {
+
+	elections := make([]leaderElection, 0)
+
+	for _, topicMetadata := range metadata {
+		if !errors.Is(topicMetadata.Err, sarama.ErrNoError) {
+			return nil, errors.Wrapf(topicMetadata.Err, "failed to describe topic '%s'", topicMetadata.Name)
+		}
+
+		for _, id := range partitions {
+			found := false
+			for _, p := range topicMetadata.Partitions {
+				found = found || p.ID == id
+			}
+			if !found {
+				return nil, errors.Errorf("partition %d does not exist for topic %s", id, topicMetadata.Name)
+			}
+		}
+
+		for _, p := range topicMetadata.Partitions {
+			if len(partitions) > 0 && !util.ContainsInt32(partitions, p.ID) {
+				continue
+			}
+			if len(p.Replicas) == 0 {
+				continue
+			}
+			if (electionType == sarama.PreferredElection && p.Leader != p.Replicas[0]) ||
+				(electionType == sarama.UncleanElection && p.Leader == noLeader) {
+				elections = append(elections, leaderElection{Topic: topicMetadata.Name, Partition: p.ID, Leader: p.Leader, Replicas: p.Replicas})
+			}
+		}
+	}
+
+	sort.Slice(elections, func(i, j int) bool {
+		if elections[i].Topic != elections[j].Topic {
+			return elections[i].Topic < elections[j].Topic
+		}
+		return elections[i].Partition < elections[j].Partition
+	})
+
+	return elections, nil
+}
+
+func electionResult(result *sarama.PartitionResult) string {
+	switch {
+	case result == nil:
+		return "no result"
+	case errors.Is(result.ErrorCode, sarama.ErrNoError):
+		return "elected"
+	case errors.Is(result.ErrorCode, sarama.ErrElectionNotNeeded):
+		return "not needed"
+	case result.ErrorMessage != nil && *result.ErrorMessage != "":
+		return *result.ErrorMessage
+	default:
+		return result.ErrorCode.Error()
+	}
+}
+
+func printLeaderElections(elections []leaderElection, withResult bool) error {
+
+	tableWriter := output.CreateTableWriter()
+
+	columns := []string{"TOPIC", "PARTITION", "LEADER", "REPLICAS"}
+	if withResult {
+		columns = append(columns, "RESULT")
+	}
+
+	if err := tableWriter.WriteHeader(columns...); err != nil {
+		return err
+	}
+
+	for _, election := range elections {
+		leader := strconv.Itoa(int(election.Leader))
+		if election.Leader == noLeader {
+			leader = "none"
+		}
+		values := []string{election.Topic, strconv.Itoa(int(election.Partition)), leader, formatReplicas(election.Replicas)}
+		if withResult {
+			values = append(values, election.Result)
+		}
+		if err := tableWriter.Write(values...); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
@@ -0,0 +1,48 @@
+package partition
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func TestElectionCandidates(t *testing.T) {
+
+	metadata := []*sarama.TopicMetadata{{
+		Name: "a",
+		Err:  sarama.ErrNoError,
+		Partitions: []*sarama.PartitionMetadata{
+			{ID: 0, Leader: 1, Replicas: []int32{1, 2}},
+			{ID: 1, Leader: 1, Replicas: []int32{2, 1}},
+			{ID: 2, Leader: -1, Replicas: []int32{3, 1}},
+		},
+	}}
+
+	preferred, err := electionCandidates(metadata, sarama.PreferredElection, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(preferred) != 2 || preferred[0].Partition != 1 || preferred[1].Partition != 2 {
+		t.Fatalf("unexpected preferred election candidates: %v", preferred)
+	}
+
+	unclean, err := electionCandidates(metadata, sarama.UncleanElection, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(unclean) != 1 || unclean[0].Partition != 2 {
+		t.Fatalf("unexpected unclean election candidates: %v", unclean)
+	}
+
+	selected, err := electionCandidates(metadata, sarama.PreferredElection, []int32{0, 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 1 || selected[0].Partition != 1 {
+		t.Fatalf("unexpected candidates for selected partitions: %v", selected)
+	}
+
+	if _, err := electionCandidates(metadata, sarama.PreferredElection, []int32{5}); err == nil {
+		t.Fatalf("expected error for unknown partition")
+	}
+}
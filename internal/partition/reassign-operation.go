@@ -0,0 +1,619 @@
+package partition
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	leaderThrottledRate       = "leader.replication.throttled.rate"
+	followerThrottledRate     = "follower.replication.throttled.rate"
+	leaderThrottledReplicas   = "leader.replication.throttled.replicas"
+	followerThrottledReplicas = "follower.replication.throttled.replicas"
+)
+
+type PlanReassignmentFlags struct {
+	Topics       []string
+	Brokers      []int32
+	Decommission []int32
+	File         string
+	OutputFormat string
+}
+
+type ExecuteReassignmentFlags struct {
+	File     string
+	Throttle string
+	Wait     bool
+	Interval time.Duration
+}
+
+type ReassignmentStatusFlags struct {
+	File         string
+	Wait         bool
+	Interval     time.Duration
+	OutputFormat string
+}
+
+type partitionReassignment struct {
+	Topic            string
+	Partition        int32
+	Status           string
+	Replicas         []int32 `yaml:"replicas,flow"`
+	TargetReplicas   []int32 `json:"targetReplicas" yaml:"targetReplicas,flow"`
+	AddingReplicas   []int32 `json:"addingReplicas,omitempty" yaml:"addingReplicas,omitempty,flow"`
+	RemovingReplicas []int32 `json:"removingReplicas,omitempty" yaml:"removingReplicas,omitempty,flow"`
+}
+
+const (
+	statusInProgress = "in progress"
+	statusCompleted  = "completed"
+	statusMismatch   = "mismatch"
+)
+
+// PlanReassignment generates a balanced reassignment plan for the given topics (names or glob patterns)
+// that moves all replicas to the target brokers.
+func (operation *Operation) PlanReassignment(flags PlanReassignmentFlags) error {
+
+	var (
+		context internal.ClientContext
+		client  sarama.Client
+		err     error
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	racks, err := targetBrokers(client, flags.Brokers, flags.Decommission)
+	if err != nil {
+		return err
+	}
+
+	topics, err := topic.SelectTopics(client, flags.Topics, topic.SelectTopicsFlags{})
+	if err != nil {
+		return err
+	}
+
+	current := make([]PartitionReplicas, 0)
+	for _, topicName := range topics {
+		replicaAssignment, err := readCurrentReplicas(&client, topicName)
+		if err != nil {
+			return errors.Wrapf(err, "unable to read current replicas for topic '%s'", topicName)
+		}
+		for partitionID, replicas := range replicaAssignment {
+			current = append(current, PartitionReplicas{Topic: topicName, Partition: int32(partitionID), Replicas: replicas})
+		}
+	}
+
+	target, err := planReassignment(current, racks)
+	if err != nil {
+		return err
+	}
+
+	plan := ReassignmentPlan{Version: 1, Partitions: make([]PartitionReplicas, 0)}
+	changes := make([]partitionReassignment, 0)
+
+	for i := range target {
+		if !replicasEqual(current[i].Replicas, target[i].Replicas) {
+			plan.Partitions = append(plan.Partitions, target[i])
+			changes = append(changes, partitionReassignment{Topic: target[i].Topic, Partition: target[i].Partition,
+				Replicas: current[i].Replicas, TargetReplicas: target[i].Replicas})
+		}
+	}
+
+	if len(plan.Partitions) == 0 {
+		output.Infof("no partitions need to be reassigned")
+		return nil
+	}
+
+	if flags.File != "" {
+		if err := writePlan(flags.File, plan); err != nil {
+			return err
+		}
+		output.Infof("reassignment plan for %d partitions written to: %s", len(plan.Partitions), flags.File)
+		return nil
+	}
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(plan, flags.OutputFormat)
+	}
+
+	return printReassignments(changes, false)
+}
+
+// ExecuteReassignment starts the reassignment of a plan. With a throttle the replication traffic of the
+// reassignment is limited until the throttle is removed by ReassignmentStatus.
+func (operation *Operation) ExecuteReassignment(flags ExecuteReassignmentFlags) error {
+
+	var (
+		context  internal.ClientContext
+		client   sarama.Client
+		admin    sarama.ClusterAdmin
+		err      error
+		throttle int64
+	)
+
+	if flags.Throttle != "" {
+		rate := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(flags.Throttle)), "/s")
+		if throttle, err = util.ParseSize(rate); err != nil || throttle == 0 {
+			return errors.Errorf("invalid throttle: %s (e.g. 50MB/s)", flags.Throttle)
+		}
+	}
+
+	plan, err := readPlan(flags.File)
+	if err != nil {
+		return err
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	currentAssignments, err := validatePlan(client, plan)
+	if err != nil {
+		return err
+	}
+
+	for topicName, partitions := range plan.partitionsByTopic() {
+		status, err := admin.ListPartitionReassignments(topicName, partitions)
+		if err != nil {
+			return errors.Wrapf(err, "unable to list partition reassignments of topic '%s'", topicName)
+		}
+		if len(status[topicName]) > 0 {
+			return errors.Errorf("a reassignment of topic '%s' is already in progress (see 'reassign status')", topicName)
+		}
+	}
+
+	if throttle > 0 {
+		if err := setThrottles(admin, plan, currentAssignments, throttle); err != nil {
+			return err
+		}
+	}
+
+	for topicName, partitions := range plan.replicasByTopic() {
+		assignment := currentAssignments[topicName]
+		for partitionID, replicas := range partitions {
+			assignment[partitionID] = replicas
+		}
+		if err := admin.AlterPartitionReassignments(topicName, assignment); err != nil {
+			return errors.Wrapf(err, "could not reassign partition replicas for topic '%s'", topicName)
+		}
+	}
+
+	output.Infof("reassignment of %d partitions started", len(plan.Partitions))
+
+	if !flags.Wait {
+		output.Infof("use 'kafkactl reassign status --file %s' to track the progress and remove throttles", flags.File)
+		return nil
+	}
+
+	return operation.ReassignmentStatus(ReassignmentStatusFlags{File: flags.File, Wait: true, Interval: flags.Interval})
+}
+
+// ReassignmentStatus prints the progress of the reassignment of a plan. Throttles are removed when
+// the reassignment is completed.
+func (operation *Operation) ReassignmentStatus(flags ReassignmentStatusFlags) error {
+
+	var (
+		context internal.ClientContext
+		client  sarama.Client
+		admin   sarama.ClusterAdmin
+		err     error
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	plan, err := readPlan(flags.File)
+	if err != nil {
+		return err
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	var reassignments []partitionReassignment
+
+	for {
+		if reassignments, err = readReassignmentStatus(client, admin, plan); err != nil {
+			return err
+		}
+
+		running := 0
+		for _, reassignment := range reassignments {
+			if reassignment.Status == statusInProgress {
+				running++
+			}
+		}
+
+		if running == 0 || !flags.Wait {
+			break
+		}
+
+		output.Infof("%d of %d partitions reassigned", len(reassignments)-running, len(reassignments))
+		time.Sleep(flags.Interval)
+	}
+
+	if flags.OutputFormat != "" {
+		if err := output.PrintObject(reassignments, flags.OutputFormat); err != nil {
+			return err
+		}
+	} else if err := printReassignments(reassignments, true); err != nil {
+		return err
+	}
+
+	for _, reassignment := range reassignments {
+		if reassignment.Status == statusInProgress {
+			return nil
+		}
+	}
+
+	if err := removeThrottles(client, admin, plan); err != nil {
+		return err
+	}
+
+	for _, reassignment := range reassignments {
+		if reassignment.Status == statusMismatch {
+			return errors.New("reassignment finished but replicas of some partitions differ from the plan")
+		}
+	}
+
+	output.Infof("reassignment completed")
+	return nil
+}
+
+func (plan ReassignmentPlan) partitionsByTopic() map[string][]int32 {
+	partitions := make(map[string][]int32)
+	for _, p := range plan.Partitions {
+		partitions[p.Topic] = append(partitions[p.Topic], p.Partition)
+	}
+	return partitions
+}
+
+func (plan ReassignmentPlan) replicasByTopic() map[string]map[int32][]int32 {
+	replicas := make(map[string]map[int32][]int32)
+	for _, p := range plan.Partitions {
+		if replicas[p.Topic] == nil {
+			replicas[p.Topic] = make(map[int32][]int32)
+		}
+		replicas[p.Topic][p.Partition] = p.Replicas
+	}
+	return replicas
+}
+
+func targetBrokers(client sarama.Client, brokerIDs, decommission []int32) (map[int32]string, error) {
+
+	available := make(map[int32]string)
+	for _, broker := range client.Brokers() {
+		available[broker.ID()] = broker.Rack()
+	}
+
+	for _, id := range append(append([]int32{}, brokerIDs...), decommission...) {
+		if _, ok := available[id]; !ok {
+			return nil, errors.Errorf("unknown broker id: %d", id)
+		}
+	}
+
+	racks := make(map[int32]string)
+	for id, rack := range available {
+		if (len(brokerIDs) == 0 || util.ContainsInt32(brokerIDs, id)) && !util.ContainsInt32(decommission, id) {
+			racks[id] = rack
+		}
+	}
+
+	if len(racks) == 0 {
+		return nil, errors.New("no target brokers left")
+	}
+	return racks, nil
+}
+
+// validatePlan checks that all topics, partitions and brokers of the plan exist and returns
+// the current replica assignment of the topics.
+func validatePlan(client sarama.Client, plan ReassignmentPlan) (map[string][][]int32, error) {
+
+	brokerIDs := make([]int32, 0)
+	for _, broker := range client.Brokers() {
+		brokerIDs = append(brokerIDs, broker.ID())
+	}
+
+	currentAssignments := make(map[string][][]int32)
+
+	for _, p := range plan.Partitions {
+		if _, ok := currentAssignments[p.Topic]; !ok {
+			exists, err := internal.TopicExists(&client, p.Topic)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, errors.Errorf("topic '%s' does not exist", p.Topic)
+			}
+			if currentAssignments[p.Topic], err = readCurrentReplicas(&client, p.Topic); err != nil {
+				return nil, errors.Wrapf(err, "unable to read current replicas for topic '%s'", p.Topic)
+			}
+		}
+
+		if p.Partition < 0 || int(p.Partition) >= len(currentAssignments[p.Topic]) {
+			return nil, errors.Errorf("partition %d does not exist for topic %s", p.Partition, p.Topic)
+		}
+
+		if len(p.Replicas) == 0 {
+			return nil, errors.Errorf("no replicas for topic %s partition %d", p.Topic, p.Partition)
+		}
+
+		for i, replica := range p.Replicas {
+			if !util.ContainsInt32(brokerIDs, replica) {
+				return nil, errors.Errorf("unknown broker id %d for topic %s partition %d", replica, p.Topic, p.Partition)
+			}
+			if util.ContainsInt32(p.Replicas[:i], replica) {
+				return nil, errors.Errorf("duplicate replica %d for topic %s partition %d", replica, p.Topic, p.Partition)
+			}
+		}
+	}
+
+	return currentAssignments, nil
+}
+
+// setThrottles limits the replication of the moving replicas like kafka-reassign-partitions.sh: leaders are
+// throttled on the current replicas, followers on the new replicas.
+func setThrottles(admin sarama.ClusterAdmin, plan ReassignmentPlan, currentAssignments map[string][][]int32, throttle int64) error {
+
+	brokers := make(map[int32]bool)
+	leaderReplicas := make(map[string][]string)
+	followerReplicas := make(map[string][]string)
+
+	for _, p := range plan.Partitions {
+		current := currentAssignments[p.Topic][p.Partition]
+		for _, replica := range current {
+			brokers[replica] = true
+			leaderReplicas[p.Topic] = append(leaderReplicas[p.Topic], fmt.Sprintf("%d:%d", p.Partition, replica))
+		}
+		for _, replica := range p.Replicas {
+			brokers[replica] = true
+			if !util.ContainsInt32(current, replica) {
+				followerReplicas[p.Topic] = append(followerReplicas[p.Topic], fmt.Sprintf("%d:%d", p.Partition, replica))
+			}
+		}
+	}
+
+	for topicName := range plan.partitionsByTopic() {
+		leader := strings.Join(leaderReplicas[topicName], ",")
+		follower := strings.Join(followerReplicas[topicName], ",")
+		entries := map[string]sarama.IncrementalAlterConfigsEntry{
+			leaderThrottledReplicas:   {Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &leader},
+			followerThrottledReplicas: {Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &follower},
+		}
+		if err := admin.IncrementalAlterConfig(sarama.TopicResource, topicName, entries, false); err != nil {
+			return errors.Wrapf(err, "unable to set throttled replicas for topic '%s'", topicName)
+		}
+	}
+
+	rate := strconv.FormatInt(throttle, 10)
+	for broker := range brokers {
+		entries := map[string]sarama.IncrementalAlterConfigsEntry{
+			leaderThrottledRate:   {Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &rate},
+			followerThrottledRate: {Operation: sarama.IncrementalAlterConfigsOperationSet, Value: &rate},
+		}
+		if err := admin.IncrementalAlterConfig(sarama.BrokerResource, strconv.Itoa(int(broker)), entries, false); err != nil {
+			return errors.Wrapf(err, "unable to set throttle for broker %d", broker)
+		}
+	}
+
+	output.Infof("replication throttled to %s bytes/s on brokers %v", rate, sortedBrokerIDs(brokers))
+	return nil
+}
+
+// removeThrottles removes the throttled replicas of the topics in the plan and the throttled rates of all brokers.
+// Only configs that are set are removed, so that nothing is changed if no throttle was used.
+func removeThrottles(client sarama.Client, admin sarama.ClusterAdmin, plan ReassignmentPlan) error {
+
+	removed := false
+
+	for topicName := range plan.partitionsByTopic() {
+		resource := sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName,
+			ConfigNames: []string{leaderThrottledReplicas, followerThrottledReplicas}}
+		if ok, err := removeConfigs(admin, resource, func(entry sarama.ConfigEntry) bool { return entry.Value != "" }); err != nil {
+			return err
+		} else if ok {
+			removed = true
+		}
+	}
+
+	for _, broker := range client.Brokers() {
+		resource := sarama.ConfigResource{Type: sarama.BrokerResource, Name: strconv.Itoa(int(broker.ID())),
+			ConfigNames: []string{leaderThrottledRate, followerThrottledRate}}
+		if ok, err := removeConfigs(admin, resource, func(entry sarama.ConfigEntry) bool { return entry.Source == sarama.SourceDynamicBroker }); err != nil {
+			return err
+		} else if ok {
+			removed = true
+		}
+	}
+
+	if removed {
+		output.Infof("replication throttles removed")
+	}
+	return nil
+}
+
+func removeConfigs(admin sarama.ClusterAdmin, resource sarama.ConfigResource, isSet func(sarama.ConfigEntry) bool) (bool, error) {
+
+	configs, err := admin.DescribeConfig(resource)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to describe configs of %s", resource.Name)
+	}
+
+	entries := make(map[string]sarama.IncrementalAlterConfigsEntry)
+	for _, config := range configs {
+		if util.ContainsString(resource.ConfigNames, config.Name) && isSet(config) {
+			entries[config.Name] = sarama.IncrementalAlterConfigsEntry{Operation: sarama.IncrementalAlterConfigsOperationDelete}
+		}
+	}
+
+	if len(entries) == 0 {
+		return false, nil
+	}
+
+	if err := admin.IncrementalAlterConfig(resource.Type, resource.Name, entries, false); err != nil {
+		return false, errors.Wrapf(err, "unable to remove throttle of %s", resource.Name)
+	}
+	return true, nil
+}
+
+func readReassignmentStatus(client sarama.Client, admin sarama.ClusterAdmin, plan ReassignmentPlan) ([]partitionReassignment, error) {
+
+	partitionsByTopic := plan.partitionsByTopic()
+	topics := make([]string, 0, len(partitionsByTopic))
+	for topicName := range partitionsByTopic {
+		topics = append(topics, topicName)
+	}
+
+	if err := client.RefreshMetadata(topics...); err != nil {
+		return nil, errors.Wrap(err, "unable to refresh metadata")
+	}
+
+	running := make(map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus)
+	for topicName, partitions := range partitionsByTopic {
+		status, err := admin.ListPartitionReassignments(topicName, partitions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list partition reassignments of topic '%s'", topicName)
+		}
+		running[topicName] = status[topicName]
+	}
+
+	reassignments := make([]partitionReassignment, 0, len(plan.Partitions))
+
+	for _, p := range plan.Partitions {
+		reassignment := partitionReassignment{Topic: p.Topic, Partition: p.Partition, TargetReplicas: p.Replicas}
+
+		if status, ok := running[p.Topic][p.Partition]; ok {
+			reassignment.Status = statusInProgress
+			reassignment.Replicas = status.Replicas
+			reassignment.AddingReplicas = status.AddingReplicas
+			reassignment.RemovingReplicas = status.RemovingReplicas
+		} else {
+			replicas, err := client.Replicas(p.Topic, p.Partition)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to read replicas for topic %s partition %d", p.Topic, p.Partition)
+			}
+			reassignment.Replicas = replicas
+			if replicasEqual(replicas, p.Replicas) {
+				reassignment.Status = statusCompleted
+			} else {
+				reassignment.Status = statusMismatch
+			}
+		}
+		reassignments = append(reassignments, reassignment)
+	}
+
+	return reassignments, nil
+}
+
+func printReassignments(reassignments []partitionReassignment, withStatus bool) error {
+
+	tableWriter := output.CreateTableWriter()
+
+	columns := []string{"TOPIC", "PARTITION", "REPLICAS", "TARGET_REPLICAS"}
+	if withStatus {
+		columns = append(columns, "STATUS")
+	}
+
+	if err := tableWriter.WriteHeader(columns...); err != nil {
+		return err
+	}
+
+	for _, r := range reassignments {
+		values := []string{r.Topic, strconv.Itoa(int(r.Partition)), formatReplicas(r.Replicas), formatReplicas(r.TargetReplicas)}
+		if withStatus {
+			values = append(values, r.Status)
+		}
+		if err := tableWriter.Write(values...); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func formatReplicas(replicas []int32) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(replicas)), ","), "[]")
+}
+
+func sortedBrokerIDs(brokers map[int32]bool) []int32 {
+	ids := make([]int32, 0, len(brokers))
+	for id := range brokers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func writePlan(path string, plan ReassignmentPlan) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal reassignment plan")
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return errors.Wrapf(err, "unable to write reassignment plan %s", path)
+	}
+	return nil
+}
+
+func readPlan(path string) (ReassignmentPlan, error) {
+	var plan ReassignmentPlan
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return plan, errors.Wrapf(err, "unable to read reassignment plan")
+	}
+
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return plan, errors.Wrapf(err, "unable to parse reassignment plan %s", path)
+	}
+
+	if len(plan.Partitions) == 0 {
+		return plan, errors.Errorf("reassignment plan %s does not contain partitions", path)
+	}
+	return plan, nil
+}
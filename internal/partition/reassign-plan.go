@@ -0,0 +1,150 @@
+package partition
+
+import (
+	"sort"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+// ReassignmentPlan is a partition reassignment plan. The json format is compatible with kafka-reassign-partitions.sh.
+type ReassignmentPlan struct {
+	Version    int                 `json:"version" yaml:"version"`
+	Partitions []PartitionReplicas `json:"partitions" yaml:"partitions"`
+}
+
+type PartitionReplicas struct {
+	Topic     string  `json:"topic" yaml:"topic"`
+	Partition int32   `json:"partition" yaml:"partition"`
+	Replicas  []int32 `json:"replicas" yaml:"replicas,flow"`
+}
+
+// planReassignment moves all replicas to the target brokers (broker id -> rack) and balances the number of
+// replicas per broker. Replicas that can stay on their broker are not moved, so that the preferred leader is
+// kept if possible. If the brokers have racks, replicas of a partition are spread across as many racks as possible.
+func planReassignment(current []PartitionReplicas, racks map[int32]string) ([]PartitionReplicas, error) {
+
+	if len(racks) == 0 {
+		return nil, errors.New("no target brokers")
+	}
+
+	brokerIDs := make([]int32, 0, len(racks))
+	distinctRacks := make(map[string]bool)
+	for id, rack := range racks {
+		brokerIDs = append(brokerIDs, id)
+		distinctRacks[rack] = true
+	}
+	sort.Slice(brokerIDs, func(i, j int) bool { return brokerIDs[i] < brokerIDs[j] })
+
+	planner := &reassignmentPlanner{racks: racks, brokerIDs: brokerIDs, numRacks: len(distinctRacks), load: make(map[int32]int)}
+
+	target := make([]PartitionReplicas, len(current))
+	totalReplicas := 0
+
+	// keep all replicas on target brokers, unless too many replicas of the partition are in the same rack
+	for i, p := range current {
+		if len(p.Replicas) > len(brokerIDs) {
+			return nil, errors.Errorf("topic %s partition %d has %d replicas but only %d target brokers are available",
+				p.Topic, p.Partition, len(p.Replicas), len(brokerIDs))
+		}
+
+		rackCount := make(map[string]int)
+		replicas := make([]int32, 0, len(p.Replicas))
+		for _, replica := range p.Replicas {
+			rack, ok := racks[replica]
+			if !ok || rackCount[rack] >= planner.maxPerRack(len(p.Replicas)) {
+				continue
+			}
+			rackCount[rack]++
+			replicas = append(replicas, replica)
+			planner.load[replica]++
+		}
+		target[i] = PartitionReplicas{Topic: p.Topic, Partition: p.Partition, Replicas: replicas}
+		totalReplicas += len(p.Replicas)
+	}
+
+	// replace removed replicas by the least loaded brokers
+	for i := range target {
+		replicationFactor := len(current[i].Replicas)
+		for len(target[i].Replicas) < replicationFactor {
+			candidate, found := planner.leastLoadedBroker(target[i].Replicas, -1, replicationFactor)
+			if !found {
+				return nil, errors.Errorf("unable to find a broker for topic %s partition %d", target[i].Topic, target[i].Partition)
+			}
+			target[i].Replicas = append(target[i].Replicas, candidate)
+			planner.load[candidate]++
+		}
+	}
+
+	// move replicas from the most to the least loaded brokers until the load is balanced
+	for moved := true; moved; {
+		moved = false
+		for i := range target {
+			replicas := target[i].Replicas
+			for j, replica := range replicas {
+				candidate, found := planner.leastLoadedBroker(replicas, j, len(replicas))
+				if found && planner.load[replica] > planner.load[candidate]+1 {
+					replicas[j] = candidate
+					planner.load[replica]--
+					planner.load[candidate]++
+					moved = true
+				}
+			}
+		}
+	}
+
+	return target, nil
+}
+
+type reassignmentPlanner struct {
+	racks     map[int32]string
+	brokerIDs []int32
+	numRacks  int
+	load      map[int32]int
+}
+
+// maxPerRack is the number of replicas of a partition that may be placed in the same rack
+func (planner *reassignmentPlanner) maxPerRack(replicationFactor int) int {
+	return (replicationFactor + planner.numRacks - 1) / planner.numRacks
+}
+
+// leastLoadedBroker finds the least loaded broker that can replace the replica at index replace
+// (or be added if replace is -1) without exceeding the replicas per rack.
+func (planner *reassignmentPlanner) leastLoadedBroker(replicas []int32, replace int, replicationFactor int) (int32, bool) {
+
+	rackCount := make(map[string]int)
+	for j, replica := range replicas {
+		if j != replace {
+			rackCount[planner.racks[replica]]++
+		}
+	}
+
+	var (
+		best  int32
+		found bool
+	)
+
+	for _, id := range planner.brokerIDs {
+		if util.ContainsInt32(replicas, id) || rackCount[planner.racks[id]] >= planner.maxPerRack(replicationFactor) {
+			continue
+		}
+		if !found || planner.load[id] < planner.load[best] {
+			best = id
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+func replicasEqual(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+package partition
+
+import (
+	"testing"
+)
+
+func brokerLoad(partitions []PartitionReplicas) map[int32]int {
+	load := make(map[int32]int)
+	for _, p := range partitions {
+		for _, replica := range p.Replicas {
+			load[replica]++
+		}
+	}
+	return load
+}
+
+func assertBalanced(t *testing.T, partitions []PartitionReplicas, racks map[int32]string) {
+	t.Helper()
+	load := brokerLoad(partitions)
+	minLoad, maxLoad := -1, 0
+	for id := range racks {
+		if minLoad == -1 || load[id] < minLoad {
+			minLoad = load[id]
+		}
+		if load[id] > maxLoad {
+			maxLoad = load[id]
+		}
+	}
+	if maxLoad-minLoad > 1 {
+		t.Fatalf("replicas are not balanced: %v", load)
+	}
+}
+
+func TestPlanReassignmentDecommissionBroker(t *testing.T) {
+
+	current := []PartitionReplicas{
+		{Topic: "a", Partition: 0, Replicas: []int32{1}},
+		{Topic: "a", Partition: 1, Replicas: []int32{2}},
+		{Topic: "a", Partition: 2, Replicas: []int32{3}},
+		{Topic: "a", Partition: 3, Replicas: []int32{4}},
+	}
+	racks := map[int32]string{1: "", 2: "", 3: ""}
+
+	target, err := planReassignment(current, racks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if !replicasEqual(current[i].Replicas, target[i].Replicas) {
+			t.Fatalf("partition %d should not be moved: %v", i, target[i].Replicas)
+		}
+	}
+	if target[3].Replicas[0] == 4 {
+		t.Fatalf("replica should be moved away from decommissioned broker")
+	}
+	assertBalanced(t, target, racks)
+}
+
+func TestPlanReassignmentAddBroker(t *testing.T) {
+
+	current := []PartitionReplicas{
+		{Topic: "a", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "a", Partition: 1, Replicas: []int32{2, 3}},
+		{Topic: "a", Partition: 2, Replicas: []int32{3, 1}},
+		{Topic: "b", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "b", Partition: 1, Replicas: []int32{2, 3}},
+		{Topic: "b", Partition: 2, Replicas: []int32{3, 1}},
+	}
+	racks := map[int32]string{1: "", 2: "", 3: "", 4: ""}
+
+	target, err := planReassignment(current, racks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, p := range target {
+		if len(p.Replicas) != 2 || p.Replicas[0] == p.Replicas[1] {
+			t.Fatalf("invalid replicas for partition %d: %v", i, p.Replicas)
+		}
+	}
+	if brokerLoad(target)[4] != 3 {
+		t.Fatalf("expected 3 replicas on the new broker: %v", brokerLoad(target))
+	}
+	assertBalanced(t, target, racks)
+}
+
+func TestPlanReassignmentIsRackAware(t *testing.T) {
+
+	current := []PartitionReplicas{
+		{Topic: "a", Partition: 0, Replicas: []int32{1, 2}},
+		{Topic: "a", Partition: 1, Replicas: []int32{3, 4}},
+	}
+	racks := map[int32]string{1: "rack-a", 2: "rack-a", 3: "rack-b", 4: "rack-b"}
+
+	target, err := planReassignment(current, racks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, p := range target {
+		if p.Replicas[0] != current[i].Replicas[0] {
+			t.Fatalf("leader of partition %d should be kept: %v", i, p.Replicas)
+		}
+		if racks[p.Replicas[0]] == racks[p.Replicas[1]] {
+			t.Fatalf("replicas of partition %d should be in different racks: %v", i, p.Replicas)
+		}
+	}
+	assertBalanced(t, target, racks)
+}
+
+func TestPlanReassignmentFailsWithTooFewBrokers(t *testing.T) {
+
+	current := []PartitionReplicas{{Topic: "a", Partition: 0, Replicas: []int32{1, 2, 3}}}
+
+	if _, err := planReassignment(current, map[int32]string{1: "", 2: ""}); err == nil {
+		t.Fatalf("expected error when replication factor exceeds the number of brokers")
+	}
+}
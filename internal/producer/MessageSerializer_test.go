@@ -3,6 +3,8 @@ package producer
 import (
 	"reflect"
 	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
 )
 
 func TestDecodeBytesBase64WhenInputIsNil(t *testing.T) {
@@ -63,3 +65,39 @@ func TestDecodeBytesBase64WithZeroPaddedInput(t *testing.T) {
 		t.Errorf("Expected nil, got %v", err)
 	}
 }
+
+func TestPartitionForKeyIsCompatibleWithJavaClient(t *testing.T) {
+	// partitions of a topic with 10 partitions as computed by the java client's DefaultPartitioner
+	// (Utils.toPositive(Utils.murmur2(keyBytes)) % numPartitions)
+	expectedPartitions := map[string]int32{
+		"":                         1,
+		"test-key":                 1,
+		"21":                       0,
+		"foobar":                   6,
+		"a-little-bit-long-string": 2,
+		"abc":                      7,
+		"äöü":                      2,
+		"schlüssel":                6,
+		"键":                        6,
+	}
+
+	for key, expected := range expectedPartitions {
+		partition, keyBytes, err := PartitionForKey("topic", key, 10, internal.ClientContext{}, Flags{})
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+		if partition != expected {
+			t.Errorf("Expected partition %d for key %q, got %d", expected, key, partition)
+		}
+		if string(keyBytes) != key {
+			t.Errorf("Expected key bytes %q, got %q", key, string(keyBytes))
+		}
+	}
+}
+
+func TestPartitionForKeyFailsForRandomPartitioner(t *testing.T) {
+	_, _, err := PartitionForKey("topic", "test-key", 2, internal.ClientContext{}, Flags{Partitioner: "random"})
+	if err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
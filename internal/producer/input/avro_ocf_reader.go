@@ -0,0 +1,73 @@
+package input
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/helpers/avro"
+)
+
+type avroOCFReader struct {
+	key       string
+	ocfReader *goavro.OCFReader
+	codec     *goavro.Codec
+}
+
+// NewAvroOCFReader creates a reader that reads records from an avro object container file.
+// Each record is converted to json using the given json codec.
+func NewAvroOCFReader(reader io.Reader, key string, jsonCodec avro.JSONCodec) (Reader, error) {
+
+	ocfReader, err := goavro.NewOCFReader(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read avro container file")
+	}
+
+	codec := ocfReader.Codec()
+
+	if jsonCodec == avro.Standard {
+		if codec, err = goavro.NewCodecForStandardJSONFull(codec.Schema()); err != nil {
+			return nil, errors.Wrap(err, "failed to parse schema of avro container file")
+		}
+	}
+
+	return &avroOCFReader{key: key, ocfReader: ocfReader, codec: codec}, nil
+}
+
+func (r *avroOCFReader) Read() (Message, error) {
+
+	if !r.ocfReader.Scan() {
+		if err := r.ocfReader.Err(); err != nil {
+			return Message{}, errors.Wrap(err, "failed to read avro container file")
+		}
+		return Message{}, io.EOF
+	}
+
+	native, err := r.ocfReader.Read()
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to read record from avro container file")
+	}
+
+	textual, err := r.codec.TextualFromNative(nil, native)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to convert avro record to json")
+	}
+
+	// goavro does not preserve the field order of records, normalize to get a stable output
+	var normalized any
+	decoder := json.NewDecoder(bytes.NewReader(textual))
+	decoder.UseNumber()
+	if err = decoder.Decode(&normalized); err != nil {
+		return Message{}, errors.Wrap(err, "failed to convert avro record to json")
+	}
+
+	if textual, err = json.Marshal(normalized); err != nil {
+		return Message{}, errors.Wrap(err, "failed to convert avro record to json")
+	}
+
+	value := string(textual)
+	return Message{Key: &r.key, Value: &value}, nil
+}
@@ -0,0 +1,82 @@
+package input
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/helpers/avro"
+)
+
+const testAvroSchema = `{"type":"record","name":"Person","fields":[{"name":"name","type":"string"},{"name":"nickname","type":["null","string"],"default":null}]}`
+
+func writeTestOCF(t *testing.T, records ...map[string]any) *bytes.Buffer {
+	var buf bytes.Buffer
+	writer, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: testAvroSchema})
+	if err != nil {
+		t.Fatalf("failed to create ocf writer: %v", err)
+	}
+	if err = writer.Append(records); err != nil {
+		t.Fatalf("failed to write records: %v", err)
+	}
+	return &buf
+}
+
+func TestAvroOCFReaderStandardJSON(t *testing.T) {
+	buf := writeTestOCF(t,
+		map[string]any{"name": "Alice", "nickname": goavro.Union("string", "Al")},
+		map[string]any{"name": "Bob", "nickname": nil},
+	)
+
+	reader, err := NewAvroOCFReader(buf, "my-key", avro.Standard)
+	if err != nil {
+		t.Fatalf("failed to create reader: %v", err)
+	}
+
+	expected := []string{`{"name":"Alice","nickname":"Al"}`, `{"name":"Bob","nickname":null}`}
+
+	for _, want := range expected {
+		message, err := reader.Read()
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+		if *message.Key != "my-key" {
+			t.Errorf("Expected my-key, got %s", *message.Key)
+		}
+		if *message.Value != want {
+			t.Errorf("Expected %s, got %s", want, *message.Value)
+		}
+	}
+
+	if _, err = reader.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected EOF, got %v", err)
+	}
+}
+
+func TestAvroOCFReaderAvroJSON(t *testing.T) {
+	buf := writeTestOCF(t, map[string]any{"name": "Alice", "nickname": goavro.Union("string", "Al")})
+
+	reader, err := NewAvroOCFReader(buf, "", avro.Avro)
+	if err != nil {
+		t.Fatalf("failed to create reader: %v", err)
+	}
+
+	message, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+
+	want := `{"name":"Alice","nickname":{"string":"Al"}}`
+	if *message.Value != want {
+		t.Errorf("Expected %s, got %s", want, *message.Value)
+	}
+}
+
+func TestAvroOCFReaderInvalidInput(t *testing.T) {
+	if _, err := NewAvroOCFReader(bytes.NewBufferString("not an avro file"), "", avro.Standard); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
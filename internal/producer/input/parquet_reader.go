@@ -0,0 +1,67 @@
+package input
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+)
+
+type parquetReader struct {
+	key    string
+	reader *parquet.Reader
+}
+
+// NewParquetReader creates a reader that reads rows from a parquet file. Each row is converted to json.
+// Parquet files can only be read with random access, input that is not a file (e.g. stdin) is buffered in memory.
+func NewParquetReader(reader io.Reader, key string) (Reader, error) {
+
+	var (
+		readerAt io.ReaderAt
+		size     int64
+	)
+
+	if file, ok := reader.(*os.File); ok && file != os.Stdin {
+		stat, err := file.Stat()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read parquet file")
+		}
+		readerAt, size = file, stat.Size()
+	} else {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read parquet file")
+		}
+		readerAt, size = bytes.NewReader(data), int64(len(data))
+	}
+
+	file, err := parquet.OpenFile(readerAt, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open parquet file")
+	}
+
+	return &parquetReader{key: key, reader: parquet.NewReader(file)}, nil
+}
+
+func (r *parquetReader) Read() (Message, error) {
+
+	row := make(map[string]any)
+
+	if err := r.reader.Read(&row); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Message{}, io.EOF
+		}
+		return Message{}, errors.Wrap(err, "failed to read row from parquet file")
+	}
+
+	textual, err := json.Marshal(row)
+	if err != nil {
+		return Message{}, errors.Wrap(err, "failed to convert parquet row to json")
+	}
+
+	value := string(textual)
+	return Message{Key: &r.key, Value: &value}, nil
+}
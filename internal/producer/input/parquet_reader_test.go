@@ -0,0 +1,87 @@
+package input
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+type testParquetRow struct {
+	Name string `parquet:"name"`
+	Age  int64  `parquet:"age"`
+}
+
+func writeTestParquet(t *testing.T, rows ...testParquetRow) []byte {
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, rows); err != nil {
+		t.Fatalf("failed to write parquet: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestParquetReaderFromStream(t *testing.T) {
+	data := writeTestParquet(t, testParquetRow{Name: "Alice", Age: 42}, testParquetRow{Name: "Bob", Age: 7})
+
+	reader, err := NewParquetReader(bytes.NewReader(data), "my-key")
+	if err != nil {
+		t.Fatalf("failed to create reader: %v", err)
+	}
+
+	expected := []string{`{"age":42,"name":"Alice"}`, `{"age":7,"name":"Bob"}`}
+
+	for _, want := range expected {
+		message, err := reader.Read()
+		if err != nil {
+			t.Fatalf("Expected nil, got %v", err)
+		}
+		if *message.Key != "my-key" {
+			t.Errorf("Expected my-key, got %s", *message.Key)
+		}
+		if *message.Value != want {
+			t.Errorf("Expected %s, got %s", want, *message.Value)
+		}
+	}
+
+	if _, err = reader.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Expected EOF, got %v", err)
+	}
+}
+
+func TestParquetReaderFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "records.parquet")
+	if err := os.WriteFile(path, writeTestParquet(t, testParquetRow{Name: "Alice", Age: 42}), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open file: %v", err)
+	}
+	defer file.Close()
+
+	reader, err := NewParquetReader(file, "")
+	if err != nil {
+		t.Fatalf("failed to create reader: %v", err)
+	}
+
+	message, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+
+	want := `{"age":42,"name":"Alice"}`
+	if *message.Value != want {
+		t.Errorf("Expected %s, got %s", want, *message.Value)
+	}
+}
+
+func TestParquetReaderInvalidInput(t *testing.T) {
+	if _, err := NewParquetReader(bytes.NewBufferString("not a parquet file"), ""); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
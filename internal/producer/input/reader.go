@@ -0,0 +1,7 @@
+package input
+
+// Reader reads messages from record based container formats (e.g. avro-ocf, parquet)
+// that cannot be parsed line by line. Read returns io.EOF when no more records are available.
+type Reader interface {
+	Read() (Message, error)
+}
@@ -60,6 +60,10 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 		return err
 	}
 
+	if err = internal.CheckMutation(&clientContext, internal.Mutation{Operation: "produce to topic " + topic}); err != nil {
+		return err
+	}
+
 	config, err := internal.CreateClientConfig(&clientContext)
 	if err != nil {
 		return err
@@ -78,36 +82,11 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 		return errors.New("separator is used to split input from stdin/file. it cannot be used together with key or value")
 	}
 
-	serializers := MessageSerializerChain{topic: topic}
-
-	if clientContext.SchemaRegistry.URL != "" {
-		client, err := internal.CreateCachingSchemaRegistry(&clientContext)
-		if err != nil {
-			return err
-		}
-		avroSerializer := AvroMessageSerializer{topic: topic, client: client, jsonCodec: clientContext.Avro.JSONCodec}
-		protobufSerializer := RegistryProtobufMessageSerializer{topic: topic, client: client}
-		jsonSchemaSerializer := JSONSchemaMessageSerializer{topic: topic, client: client}
-
-		serializers.serializers = append(serializers.serializers, avroSerializer, protobufSerializer, jsonSchemaSerializer)
-	}
-	context := clientContext.Protobuf
-	context.ProtosetFiles = append(flags.ProtosetFiles, context.ProtosetFiles...)
-	context.ProtoFiles = append(flags.ProtoFiles, context.ProtoFiles...)
-	context.ProtoImportPaths = append(flags.ProtoImportPaths, context.ProtoImportPaths...)
-
-	if len(context.ProtoFiles) != 0 || len(context.ProtoImportPaths) != 0 || len(context.ProtosetFiles) != 0 {
-
-		serializer, err := CreateProtobufMessageSerializer(topic, context, protoreflect.FullName(flags.KeyProtoType), protoreflect.FullName(flags.ValueProtoType))
-		if err != nil {
-			return err
-		}
-
-		serializers.serializers = append(serializers.serializers, serializer)
+	serializers, err := CreateMessageSerializerChain(topic, clientContext, flags)
+	if err != nil {
+		return err
 	}
 
-	serializers.serializers = append(serializers.serializers, DefaultMessageSerializer{topic: topic})
-
 	output.Debugf("producer config: %+v", config.Producer)
 	producer, err := sarama.NewSyncProducer(clientContext.Brokers, config)
 	if err != nil {
@@ -184,31 +163,38 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 		}
 
 		var inputReader io.Reader
-		var inputParser input.Parser
 
 		if flags.File != "" {
-			inputReader, err = os.Open(flags.File)
+			file, err := os.Open(flags.File)
 			if err != nil {
 				return errors.Errorf("unable to read input file %s: %v", flags.File, err)
 			}
+			defer file.Close()
+			inputReader = file
 		} else {
 			inputReader = os.Stdin
 		}
 
-		if flags.InputFormat == "json" {
-			inputParser = input.NewJSONParser()
-		} else {
-			inputParser = input.NewCsvParser(flags.Key, flags.Separator)
-		}
-
-		scanner := bufio.NewScanner(inputReader)
-		scanner.Buffer(make([]byte, 0, config.Producer.MaxMessageBytes), config.Producer.MaxMessageBytes)
+		var messageReader input.Reader
 
-		if len(flags.LineSeparator) > 0 && flags.LineSeparator != "\n" {
-			scanner.Split(splitAt(util.ConvertControlChars(flags.LineSeparator)))
+		switch flags.InputFormat {
+		case "avro-ocf":
+			if messageReader, err = input.NewAvroOCFReader(inputReader, flags.Key, clientContext.Avro.JSONCodec); err != nil {
+				return err
+			}
+		case "parquet":
+			if messageReader, err = input.NewParquetReader(inputReader, flags.Key); err != nil {
+				return err
+			}
+		case "json":
+			messageReader = newLineReader(inputReader, input.NewJSONParser(), config.Producer.MaxMessageBytes, flags.LineSeparator)
+		case "", "csv":
+			messageReader = newLineReader(inputReader, input.NewCsvParser(flags.Key, flags.Separator), config.Producer.MaxMessageBytes, flags.LineSeparator)
+		default:
+			return errors.Errorf("unknown input format: %s", flags.InputFormat)
 		}
 
-		for scanner.Scan() {
+		for {
 
 			select {
 			case <-cancel:
@@ -216,17 +202,11 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 			default:
 			}
 
-			line, err := scanner.Text(), scanner.Err()
-			if err != nil {
-				return failWithMessageCount(messageCount, "Failed to read data from the standard input: %v", err)
-			}
-
-			if strings.TrimSpace(line) == "" {
-				continue
-			}
-
-			if inputMessage, err = inputParser.ParseLine(line); err != nil {
-				return failWithMessageCount(messageCount, "failed to parse line: %v", err.Error()) //nolint:govet
+			if inputMessage, err = messageReader.Read(); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return failWithMessageCount(messageCount, "%v", err)
 			}
 
 			messageCount++
@@ -245,10 +225,6 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 			}
 		}
 
-		if scanner.Err() != nil {
-			return errors.Wrap(scanner.Err(), "error reading input (try specifying --max-message-bytes when producing long messages)")
-		}
-
 		output.Infof("\r%d messages produced", messageCount)
 	} else {
 		return errors.New("value is required, or you have to provide the value on stdin")
@@ -256,6 +232,82 @@ func (operation *Operation) Produce(topic string, flags Flags) error {
 	return nil
 }
 
+// CreateMessageSerializerChain creates the chain of serializers used to encode the messages of a topic.
+func CreateMessageSerializerChain(topic string, clientContext internal.ClientContext, flags Flags) (MessageSerializerChain, error) {
+	serializers := MessageSerializerChain{topic: topic}
+
+	if clientContext.SchemaRegistry.URL != "" {
+		client, err := internal.CreateCachingSchemaRegistry(&clientContext)
+		if err != nil {
+			return serializers, err
+		}
+		avroSerializer := AvroMessageSerializer{topic: topic, client: client, jsonCodec: clientContext.Avro.JSONCodec}
+		protobufSerializer := RegistryProtobufMessageSerializer{topic: topic, client: client}
+		jsonSchemaSerializer := JSONSchemaMessageSerializer{topic: topic, client: client}
+
+		serializers.serializers = append(serializers.serializers, avroSerializer, protobufSerializer, jsonSchemaSerializer)
+	}
+	context := clientContext.Protobuf
+	context.ProtosetFiles = append(flags.ProtosetFiles, context.ProtosetFiles...)
+	context.ProtoFiles = append(flags.ProtoFiles, context.ProtoFiles...)
+	context.ProtoImportPaths = append(flags.ProtoImportPaths, context.ProtoImportPaths...)
+
+	if len(context.ProtoFiles) != 0 || len(context.ProtoImportPaths) != 0 || len(context.ProtosetFiles) != 0 {
+
+		serializer, err := CreateProtobufMessageSerializer(topic, context, protoreflect.FullName(flags.KeyProtoType), protoreflect.FullName(flags.ValueProtoType))
+		if err != nil {
+			return serializers, err
+		}
+
+		serializers.serializers = append(serializers.serializers, serializer)
+	}
+
+	serializers.serializers = append(serializers.serializers, DefaultMessageSerializer{topic: topic})
+
+	return serializers, nil
+}
+
+// PartitionForKey returns the partition a message with the given key is produced to, using the partitioner
+// configured in the context or flags. The key is encoded with the serializers of the topic before partitioning.
+func PartitionForKey(topic string, key string, numPartitions int32, clientContext internal.ClientContext, flags Flags) (int32, []byte, error) {
+
+	partitioner := clientContext.Producer.Partitioner
+	if flags.Partitioner != "" {
+		partitioner = flags.Partitioner
+	}
+
+	if partitioner == "random" || partitioner == "manual" {
+		return -1, nil, errors.Errorf("partitioner %s does not allow to compute the partition of a key", partitioner)
+	}
+
+	// never use the manual partitioner when computing the partition of a key
+	flags.Partition = -1
+
+	partitionerConstructor, err := parsePartitioner(partitioner, flags)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	serializers, err := CreateMessageSerializerChain(topic, clientContext, flags)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	keyBytes, err := serializers.serializeKey([]byte(key), flags)
+	if err != nil {
+		return -1, nil, errors.Wrap(err, "failed to serialize key")
+	}
+
+	message := &sarama.ProducerMessage{Topic: topic, Key: sarama.ByteEncoder(keyBytes)}
+
+	partition, err := partitionerConstructor(topic).Partition(message, numPartitions)
+	if err != nil {
+		return -1, nil, errors.Wrap(err, "failed to compute partition")
+	}
+
+	return partition, keyBytes, nil
+}
+
 func applyProducerConfigs(config *sarama.Config, clientContext internal.ClientContext, flags Flags) error {
 	var err error
 
@@ -328,6 +380,44 @@ func parsePartitioner(partitioner string, flags Flags) (sarama.PartitionerConstr
 	}
 }
 
+// lineReader reads messages from line based input formats (csv, json)
+type lineReader struct {
+	scanner *bufio.Scanner
+	parser  input.Parser
+}
+
+func newLineReader(reader io.Reader, parser input.Parser, maxMessageBytes int, lineSeparator string) input.Reader {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, maxMessageBytes), maxMessageBytes)
+
+	if len(lineSeparator) > 0 && lineSeparator != "\n" {
+		scanner.Split(splitAt(util.ConvertControlChars(lineSeparator)))
+	}
+
+	return &lineReader{scanner: scanner, parser: parser}
+}
+
+func (r *lineReader) Read() (input.Message, error) {
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		message, err := r.parser.ParseLine(line)
+		if err != nil {
+			return message, errors.Errorf("failed to parse line: %v", err)
+		}
+		return message, nil
+	}
+
+	if r.scanner.Err() != nil {
+		return input.Message{}, errors.Wrap(r.scanner.Err(), "error reading input (try specifying --max-message-bytes when producing long messages)")
+	}
+	return input.Message{}, io.EOF
+}
+
 func failWithMessageCount(messageCount int, errorMessage string, args ...interface{}) error {
 	output.Infof("\r%d messages produced", messageCount)
 	return errors.Errorf(errorMessage, args...)
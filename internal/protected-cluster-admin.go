@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// protectedClusterAdmin checks all modifications against the protection config of the context
+// before delegating to the cluster admin. Validation-only requests are always allowed, except for
+// the deletion of acls.
+type protectedClusterAdmin struct {
+	sarama.ClusterAdmin
+	context *ClientContext
+}
+
+func (admin *protectedClusterAdmin) check(mutation Mutation, validateOnly bool) error {
+	if validateOnly {
+		return nil
+	}
+	return CheckMutation(admin.context, mutation)
+}
+
+func (admin *protectedClusterAdmin) CreateTopic(topic string, detail *sarama.TopicDetail, validateOnly bool) error {
+	if err := admin.check(Mutation{Operation: "create topic " + topic, Topics: []string{topic}}, validateOnly); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.CreateTopic(topic, detail, validateOnly)
+}
+
+func (admin *protectedClusterAdmin) DeleteTopic(topic string) error {
+	if err := admin.check(Mutation{Operation: "delete topic " + topic, Topics: []string{topic}, Destructive: true}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.DeleteTopic(topic)
+}
+
+func (admin *protectedClusterAdmin) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) error {
+	if err := admin.check(Mutation{Operation: "create partitions of topic " + topic, Topics: []string{topic}}, validateOnly); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.CreatePartitions(topic, count, assignment, validateOnly)
+}
+
+func (admin *protectedClusterAdmin) AlterPartitionReassignments(topic string, assignment [][]int32) error {
+	if err := admin.check(Mutation{Operation: "reassign partitions of topic " + topic, Topics: []string{topic}}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.AlterPartitionReassignments(topic, assignment)
+}
+
+func (admin *protectedClusterAdmin) DeleteRecords(topic string, partitionOffsets map[int32]int64) error {
+	if err := admin.check(Mutation{Operation: "delete records of topic " + topic, Topics: []string{topic}, Destructive: true}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.DeleteRecords(topic, partitionOffsets)
+}
+
+func (admin *protectedClusterAdmin) AlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]*string, validateOnly bool) error {
+	if err := admin.check(configMutation(resourceType, name), validateOnly); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.AlterConfig(resourceType, name, entries, validateOnly)
+}
+
+func (admin *protectedClusterAdmin) IncrementalAlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]sarama.IncrementalAlterConfigsEntry, validateOnly bool) error {
+	if err := admin.check(configMutation(resourceType, name), validateOnly); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.IncrementalAlterConfig(resourceType, name, entries, validateOnly)
+}
+
+func (admin *protectedClusterAdmin) CreateACL(resource sarama.Resource, acl sarama.Acl) error {
+	if err := admin.check(Mutation{Operation: "create acl"}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.CreateACL(resource, acl)
+}
+
+func (admin *protectedClusterAdmin) CreateACLs(resourceACLs []*sarama.ResourceAcls) error {
+	if err := admin.check(Mutation{Operation: "create acls"}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.CreateACLs(resourceACLs)
+}
+
+// DeleteACL is checked even if validateOnly is set, because sarama ignores validateOnly and always deletes
+// the acls. Validation-only requests list the matching acls instead of deleting them.
+func (admin *protectedClusterAdmin) DeleteACL(filter sarama.AclFilter, validateOnly bool) ([]sarama.MatchingAcl, error) {
+	if err := admin.check(Mutation{Operation: "delete acls", Destructive: true}, false); err != nil {
+		return nil, err
+	}
+	if validateOnly {
+		return listMatchingACLs(admin.ClusterAdmin, filter)
+	}
+	return admin.ClusterAdmin.DeleteACL(filter, false)
+}
+
+// listMatchingACLs returns the acls that would be deleted with the filter.
+func listMatchingACLs(admin sarama.ClusterAdmin, filter sarama.AclFilter) ([]sarama.MatchingAcl, error) {
+	resourceAcls, err := admin.ListAcls(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingAcls []sarama.MatchingAcl
+	for _, resourceAcl := range resourceAcls {
+		for _, acl := range resourceAcl.Acls {
+			matchingAcls = append(matchingAcls, sarama.MatchingAcl{Err: sarama.ErrNoError, Resource: resourceAcl.Resource, Acl: *acl})
+		}
+	}
+	return matchingAcls, nil
+}
+
+func (admin *protectedClusterAdmin) ElectLeaders(electionType sarama.ElectionType, partitions map[string][]int32) (map[string]map[int32]*sarama.PartitionResult, error) {
+	if err := admin.check(Mutation{Operation: "elect leaders"}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.ElectLeaders(electionType, partitions)
+}
+
+func (admin *protectedClusterAdmin) AlterConsumerGroupOffsets(group string, offsets map[string]map[int32]sarama.OffsetAndMetadata, options *sarama.AlterConsumerGroupOffsetsOptions) (*sarama.OffsetCommitResponse, error) {
+	if err := admin.check(Mutation{Operation: "alter offsets of consumer-group " + group, Destructive: true}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.AlterConsumerGroupOffsets(group, offsets, options)
+}
+
+func (admin *protectedClusterAdmin) DeleteConsumerGroupOffset(group string, topic string, partition int32) error {
+	if err := admin.check(Mutation{Operation: "delete offsets of consumer-group " + group, Destructive: true}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.DeleteConsumerGroupOffset(group, topic, partition)
+}
+
+func (admin *protectedClusterAdmin) DeleteConsumerGroup(group string) error {
+	if err := admin.check(Mutation{Operation: "delete consumer-group " + group, Destructive: true}, false); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.DeleteConsumerGroup(group)
+}
+
+func (admin *protectedClusterAdmin) DeleteUserScramCredentials(deletions []sarama.AlterUserScramCredentialsDelete) ([]*sarama.AlterUserScramCredentialsResult, error) {
+	if err := admin.check(Mutation{Operation: "delete user credentials", Destructive: true}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.DeleteUserScramCredentials(deletions)
+}
+
+func (admin *protectedClusterAdmin) UpsertUserScramCredentials(upserts []sarama.AlterUserScramCredentialsUpsert) ([]*sarama.AlterUserScramCredentialsResult, error) {
+	if err := admin.check(Mutation{Operation: "alter user credentials"}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.UpsertUserScramCredentials(upserts)
+}
+
+func (admin *protectedClusterAdmin) UpdateFeatures(featureUpdates []sarama.FeatureUpdate) ([]sarama.UpdatableFeatureResult, error) {
+	if err := admin.check(Mutation{Operation: "update features"}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.UpdateFeatures(featureUpdates)
+}
+
+func (admin *protectedClusterAdmin) AlterClientQuotas(entity []sarama.QuotaEntityComponent, op sarama.ClientQuotasOp, validateOnly bool) error {
+	if err := admin.check(Mutation{Operation: "alter client quotas"}, validateOnly); err != nil {
+		return err
+	}
+	return admin.ClusterAdmin.AlterClientQuotas(entity, op, validateOnly)
+}
+
+func (admin *protectedClusterAdmin) RemoveMemberFromConsumerGroup(groupID string, groupInstanceIDs []string) (*sarama.LeaveGroupResponse, error) {
+	if err := admin.check(Mutation{Operation: "remove members from consumer-group " + groupID}, false); err != nil {
+		return nil, err
+	}
+	return admin.ClusterAdmin.RemoveMemberFromConsumerGroup(groupID, groupInstanceIDs)
+}
+
+func configMutation(resourceType sarama.ConfigResourceType, name string) Mutation {
+	switch resourceType {
+	case sarama.TopicResource:
+		return Mutation{Operation: "alter config of topic " + name, Topics: []string{name}}
+	case sarama.BrokerResource:
+		return Mutation{Operation: "alter config of broker " + name}
+	case sarama.BrokerLoggerResource:
+		return Mutation{Operation: "alter loggers of broker " + name}
+	default:
+		return Mutation{Operation: fmt.Sprintf("alter config of %s", name)}
+	}
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/global"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+)
+
+// ProtectionConfig guards a context against accidental modifications.
+type ProtectionConfig struct {
+	// ReadOnly rejects all modifications
+	ReadOnly bool
+	// ProtectedTopics are glob patterns of topics that must not be deleted or altered
+	ProtectedTopics []string
+	// RequireConfirmation asks to type the context name before destructive operations
+	RequireConfirmation bool
+}
+
+// Mutation describes a modification of the cluster that is checked against the protection config.
+type Mutation struct {
+	// Operation is a human-readable description like "delete topic my-topic"
+	Operation string
+	// Topics are the topics that are modified
+	Topics []string
+	// Destructive mutations need a confirmation if required by the context
+	Destructive bool
+}
+
+// CheckMutation verifies that the mutation is allowed by the protection config of the context and
+// asks for a confirmation if required. Confirmations are skipped with --yes.
+func CheckMutation(context *ClientContext, mutation Mutation) error {
+
+	protection := context.Protection
+
+	if protection.ReadOnly {
+		return errors.Errorf("context '%s' is read-only: %s is not allowed", context.Name, mutation.Operation)
+	}
+
+	for _, pattern := range protection.ProtectedTopics {
+		topicGlob, err := glob.Compile(pattern)
+		if err != nil {
+			return errors.Wrapf(err, "invalid protected topic pattern in context '%s': %s", context.Name, pattern)
+		}
+		for _, topic := range mutation.Topics {
+			if topicGlob.Match(topic) {
+				return errors.Errorf("topic '%s' is protected in context '%s': %s is not allowed", topic, context.Name, mutation.Operation)
+			}
+		}
+	}
+
+	if !mutation.Destructive || !protection.RequireConfirmation || context.confirmed {
+		return nil
+	}
+
+	if global.GetFlags().SkipConfirmation {
+		output.Debugf("skipping confirmation of %s in context %s", mutation.Operation, context.Name)
+		return nil
+	}
+
+	question := fmt.Sprintf("context '%s' requires a confirmation to %s.", context.Name, mutation.Operation)
+
+	confirmed, err := output.ConfirmByTyping(question, context.Name)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("aborted")
+	}
+
+	context.confirmed = true
+	return nil
+}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/global"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+)
+
+func TestCheckMutationReadOnly(t *testing.T) {
+
+	global.NewConfig()
+
+	context := ClientContext{Name: "prod", Protection: ProtectionConfig{ReadOnly: true}}
+
+	err := CheckMutation(&context, Mutation{Operation: "create topic my-topic"})
+	if err == nil || !strings.Contains(err.Error(), "context 'prod' is read-only: create topic my-topic is not allowed") {
+		t.Fatalf("expected read-only error, got: %v", err)
+	}
+}
+
+func TestCheckMutationProtectedTopics(t *testing.T) {
+
+	global.NewConfig()
+
+	context := ClientContext{Name: "prod", Protection: ProtectionConfig{ProtectedTopics: []string{"orders", "payments-*"}}}
+
+	for _, topic := range []string{"orders", "payments-eu"} {
+		err := CheckMutation(&context, Mutation{Operation: "delete topic " + topic, Topics: []string{topic}, Destructive: true})
+		if err == nil || !strings.Contains(err.Error(), "topic '"+topic+"' is protected in context 'prod'") {
+			t.Fatalf("expected protected topic error for %s, got: %v", topic, err)
+		}
+	}
+
+	if err := CheckMutation(&context, Mutation{Operation: "delete topic orders-eu", Topics: []string{"orders-eu"}, Destructive: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckMutationRequiresConfirmation(t *testing.T) {
+
+	config := global.NewConfig()
+	output.NewTestIOStreams(nil)
+
+	context := ClientContext{Name: "prod", Protection: ProtectionConfig{RequireConfirmation: true}}
+
+	if err := CheckMutation(&context, Mutation{Operation: "create topic my-topic"}); err != nil {
+		t.Fatalf("non destructive mutations need no confirmation: %v", err)
+	}
+
+	err := CheckMutation(&context, Mutation{Operation: "delete topic my-topic", Destructive: true})
+	if err == nil || !strings.Contains(err.Error(), "confirmation required but no terminal available") {
+		t.Fatalf("expected confirmation error, got: %v", err)
+	}
+
+	confirmedContext := ClientContext{Name: "prod", Protection: ProtectionConfig{RequireConfirmation: true}, confirmed: true}
+	if err := CheckMutation(&confirmedContext, Mutation{Operation: "delete topic my-topic", Destructive: true}); err != nil {
+		t.Fatalf("confirmed context should not ask again: %v", err)
+	}
+
+	config.Flags().SkipConfirmation = true
+
+	if err := CheckMutation(&context, Mutation{Operation: "delete topic my-topic", Destructive: true}); err != nil {
+		t.Fatalf("confirmation should be skipped: %v", err)
+	}
+}
+
+type aclAdminStub struct {
+	sarama.ClusterAdmin
+	acls    []sarama.ResourceAcls
+	deleted bool
+}
+
+func (admin *aclAdminStub) ListAcls(sarama.AclFilter) ([]sarama.ResourceAcls, error) {
+	return admin.acls, nil
+}
+
+func (admin *aclAdminStub) DeleteACL(sarama.AclFilter, bool) ([]sarama.MatchingAcl, error) {
+	admin.deleted = true
+	return nil, nil
+}
+
+func TestProtectedClusterAdminDeleteACLValidateOnly(t *testing.T) {
+
+	global.NewConfig()
+
+	stub := &aclAdminStub{acls: []sarama.ResourceAcls{{
+		Resource: sarama.Resource{ResourceType: sarama.AclResourceTopic, ResourceName: "orders"},
+		Acls:     []*sarama.Acl{{Principal: "User:alice", Host: "*", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow}},
+	}}}
+
+	admin := &protectedClusterAdmin{ClusterAdmin: stub, context: &ClientContext{Name: "prod"}}
+
+	matching, err := admin.DeleteACL(sarama.AclFilter{}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stub.deleted {
+		t.Fatal("acls must not be deleted with validateOnly")
+	}
+	if len(matching) != 1 || matching[0].Resource.ResourceName != "orders" || matching[0].Principal != "User:alice" {
+		t.Fatalf("unexpected matching acls: %+v", matching)
+	}
+
+	admin.context.Protection.ReadOnly = true
+	if _, err = admin.DeleteACL(sarama.AclFilter{}, true); err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Fatalf("expected read-only error for validateOnly, got: %v", err)
+	}
+}
@@ -0,0 +1,417 @@
+package quota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	ProducerByteRate       = "producer_byte_rate"
+	ConsumerByteRate       = "consumer_byte_rate"
+	RequestPercentage      = "request_percentage"
+	ControllerMutationRate = "controller_mutation_rate"
+)
+
+// defaultEntity is the name of the default user or client-id, as printed by kafka-configs.sh
+const defaultEntity = "<default>"
+
+var quotaKeys = []string{ProducerByteRate, ConsumerByteRate, RequestPercentage, ControllerMutationRate}
+
+type Quota struct {
+	User     string             `json:"user,omitempty" yaml:"user,omitempty"`
+	ClientID string             `json:"clientId,omitempty" yaml:"clientId,omitempty"`
+	Values   map[string]float64 `json:"values" yaml:"values"`
+}
+
+type EntityFlags struct {
+	User            string
+	ClientID        string
+	DefaultUser     bool
+	DefaultClientID bool
+}
+
+type GetQuotasFlags struct {
+	EntityFlags
+	OutputFormat string
+}
+
+type DescribeQuotaFlags struct {
+	EntityFlags
+	OutputFormat string
+}
+
+type AlterQuotaFlags struct {
+	EntityFlags
+	ProducerByteRate       string
+	ConsumerByteRate       string
+	RequestPercentage      string
+	ControllerMutationRate string
+	ValidateOnly           bool
+}
+
+type DeleteQuotaFlags struct {
+	EntityFlags
+	Keys []string
+}
+
+type Operation struct{}
+
+func (operation *Operation) GetQuotas(flags GetQuotasFlags) error {
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown output format: %s", flags.OutputFormat)
+	}
+
+	filter, err := flags.filterComponents()
+	if err != nil {
+		return err
+	}
+
+	admin, err := createClusterAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	quotas, err := describeQuotas(admin, filter, false)
+	if err != nil {
+		return err
+	}
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(quotas, flags.OutputFormat)
+	}
+
+	tableWriter := output.CreateTableWriter()
+	if err := tableWriter.WriteHeader("USER", "CLIENT_ID", "PRODUCER_BYTE_RATE", "CONSUMER_BYTE_RATE", "REQUEST_PERCENTAGE",
+		"CONTROLLER_MUTATION_RATE"); err != nil {
+		return err
+	}
+
+	for _, quota := range quotas {
+		values := []string{quota.User, quota.ClientID}
+		for _, key := range quotaKeys {
+			values = append(values, formatValue(quota.Values, key))
+		}
+		if err := tableWriter.Write(values...); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func (operation *Operation) DescribeQuota(flags DescribeQuotaFlags) error {
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown output format: %s", flags.OutputFormat)
+	}
+
+	filter, err := flags.filterComponents()
+	if err != nil {
+		return err
+	}
+
+	if len(filter) == 0 {
+		return errors.New("a user or client-id is required")
+	}
+
+	admin, err := createClusterAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	quotas, err := describeQuotas(admin, filter, true)
+	if err != nil {
+		return err
+	}
+
+	if len(quotas) == 0 {
+		return errors.Errorf("no quota found for %s", flags.describe())
+	}
+
+	if flags.OutputFormat != "" {
+		return output.PrintObject(quotas[0], flags.OutputFormat)
+	}
+
+	keys := make([]string, 0, len(quotas[0].Values))
+	for key := range quotas[0].Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tableWriter := output.CreateTableWriter()
+	if err := tableWriter.WriteHeader("QUOTA", "VALUE"); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := tableWriter.Write(key, formatValue(quotas[0].Values, key)); err != nil {
+			return err
+		}
+	}
+
+	return tableWriter.Flush()
+}
+
+func (operation *Operation) AlterQuota(flags AlterQuotaFlags) error {
+
+	entity, err := flags.entityComponents()
+	if err != nil {
+		return err
+	}
+
+	values, err := flags.values()
+	if err != nil {
+		return err
+	}
+
+	admin, err := createClusterAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	for _, key := range quotaKeys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		if err := admin.AlterClientQuotas(entity, sarama.ClientQuotasOp{Key: key, Value: value}, flags.ValidateOnly); err != nil {
+			return errors.Wrapf(err, "failed to alter %s of %s", key, flags.describe())
+		}
+	}
+
+	if flags.ValidateOnly {
+		output.Infof("quota of %s is valid", flags.describe())
+	} else {
+		output.Infof("quota of %s has been altered", flags.describe())
+	}
+	return nil
+}
+
+func (operation *Operation) DeleteQuota(flags DeleteQuotaFlags) error {
+
+	entity, err := flags.entityComponents()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range flags.Keys {
+		if !util.ContainsString(quotaKeys, key) {
+			return errors.Errorf("unknown quota: %s. One of: %s", key, strings.Join(quotaKeys, "|"))
+		}
+	}
+
+	admin, err := createClusterAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	filter, _ := flags.filterComponents()
+
+	quotas, err := describeQuotas(admin, filter, true)
+	if err != nil {
+		return err
+	}
+
+	if len(quotas) == 0 {
+		return errors.Errorf("no quota found for %s", flags.describe())
+	}
+
+	keys := flags.Keys
+	if len(keys) == 0 {
+		for key := range quotas[0].Values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+
+	for _, key := range keys {
+		if _, ok := quotas[0].Values[key]; !ok {
+			continue
+		}
+		if err := admin.AlterClientQuotas(entity, sarama.ClientQuotasOp{Key: key, Remove: true}, false); err != nil {
+			return errors.Wrapf(err, "failed to delete %s of %s", key, flags.describe())
+		}
+	}
+
+	output.Infof("quota of %s has been deleted", flags.describe())
+	return nil
+}
+
+func createClusterAdmin() (sarama.ClusterAdmin, error) {
+
+	context, err := internal.CreateClientContext()
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := internal.CreateClusterAdmin(&context)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cluster admin")
+	}
+	return admin, nil
+}
+
+func describeQuotas(admin sarama.ClusterAdmin, filter []sarama.QuotaFilterComponent, strict bool) ([]Quota, error) {
+
+	entries, err := admin.DescribeClientQuotas(filter, strict)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe quotas")
+	}
+
+	quotas := make([]Quota, 0, len(entries))
+
+	for _, entry := range entries {
+		quota := Quota{Values: entry.Values}
+		for _, component := range entry.Entity {
+			name := component.Name
+			if component.MatchType == sarama.QuotaMatchDefault {
+				name = defaultEntity
+			}
+			switch component.EntityType {
+			case sarama.QuotaEntityUser:
+				quota.User = name
+			case sarama.QuotaEntityClientID:
+				quota.ClientID = name
+			}
+		}
+		// quotas of ips are not supported
+		if quota.User != "" || quota.ClientID != "" {
+			quotas = append(quotas, quota)
+		}
+	}
+
+	sort.Slice(quotas, func(i, j int) bool {
+		if quotas[i].User != quotas[j].User {
+			return quotas[i].User < quotas[j].User
+		}
+		return quotas[i].ClientID < quotas[j].ClientID
+	})
+
+	return quotas, nil
+}
+
+func (flags EntityFlags) validate() error {
+	if flags.User != "" && flags.DefaultUser {
+		return errors.New("--user and --default-user cannot be combined")
+	}
+	if flags.ClientID != "" && flags.DefaultClientID {
+		return errors.New("--client-id and --default-client-id cannot be combined")
+	}
+	return nil
+}
+
+func (flags EntityFlags) entityComponents() ([]sarama.QuotaEntityComponent, error) {
+
+	if err := flags.validate(); err != nil {
+		return nil, err
+	}
+
+	entity := make([]sarama.QuotaEntityComponent, 0, 2)
+
+	if flags.DefaultUser {
+		entity = append(entity, sarama.QuotaEntityComponent{EntityType: sarama.QuotaEntityUser, MatchType: sarama.QuotaMatchDefault})
+	} else if flags.User != "" {
+		entity = append(entity, sarama.QuotaEntityComponent{EntityType: sarama.QuotaEntityUser, MatchType: sarama.QuotaMatchExact, Name: flags.User})
+	}
+
+	if flags.DefaultClientID {
+		entity = append(entity, sarama.QuotaEntityComponent{EntityType: sarama.QuotaEntityClientID, MatchType: sarama.QuotaMatchDefault})
+	} else if flags.ClientID != "" {
+		entity = append(entity, sarama.QuotaEntityComponent{EntityType: sarama.QuotaEntityClientID, MatchType: sarama.QuotaMatchExact, Name: flags.ClientID})
+	}
+
+	if len(entity) == 0 {
+		return nil, errors.New("a user or client-id is required")
+	}
+	return entity, nil
+}
+
+func (flags EntityFlags) filterComponents() ([]sarama.QuotaFilterComponent, error) {
+
+	if err := flags.validate(); err != nil {
+		return nil, err
+	}
+
+	filter := make([]sarama.QuotaFilterComponent, 0, 2)
+
+	if flags.DefaultUser {
+		filter = append(filter, sarama.QuotaFilterComponent{EntityType: sarama.QuotaEntityUser, MatchType: sarama.QuotaMatchDefault})
+	} else if flags.User != "" {
+		filter = append(filter, sarama.QuotaFilterComponent{EntityType: sarama.QuotaEntityUser, MatchType: sarama.QuotaMatchExact, Match: flags.User})
+	}
+
+	if flags.DefaultClientID {
+		filter = append(filter, sarama.QuotaFilterComponent{EntityType: sarama.QuotaEntityClientID, MatchType: sarama.QuotaMatchDefault})
+	} else if flags.ClientID != "" {
+		filter = append(filter, sarama.QuotaFilterComponent{EntityType: sarama.QuotaEntityClientID, MatchType: sarama.QuotaMatchExact, Match: flags.ClientID})
+	}
+
+	return filter, nil
+}
+
+func (flags EntityFlags) describe() string {
+	parts := make([]string, 0, 2)
+	if flags.DefaultUser {
+		parts = append(parts, "default user")
+	} else if flags.User != "" {
+		parts = append(parts, "user '"+flags.User+"'")
+	}
+	if flags.DefaultClientID {
+		parts = append(parts, "default client-id")
+	} else if flags.ClientID != "" {
+		parts = append(parts, "client-id '"+flags.ClientID+"'")
+	}
+	return strings.Join(parts, " and ")
+}
+
+// values parses the quotas to set. Byte rates support units like 10MB.
+func (flags AlterQuotaFlags) values() (map[string]float64, error) {
+
+	values := make(map[string]float64)
+
+	for key, rate := range map[string]string{ProducerByteRate: flags.ProducerByteRate, ConsumerByteRate: flags.ConsumerByteRate} {
+		if rate == "" {
+			continue
+		}
+		value, err := util.ParseSize(rate)
+		if err != nil {
+			return nil, errors.Errorf("invalid %s: %s", key, rate)
+		}
+		values[key] = float64(value)
+	}
+
+	for key, rate := range map[string]string{RequestPercentage: flags.RequestPercentage, ControllerMutationRate: flags.ControllerMutationRate} {
+		if rate == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(rate, 64)
+		if err != nil || value < 0 {
+			return nil, errors.Errorf("invalid %s: %s", key, rate)
+		}
+		values[key] = value
+	}
+
+	if len(values) == 0 {
+		return nil, errors.New("at least one quota is required")
+	}
+	return values, nil
+}
+
+func formatValue(values map[string]float64, key string) string {
+	if value, ok := values[key]; ok {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+)
+
+func TestEntityComponents(t *testing.T) {
+
+	entity, err := EntityFlags{User: "alice", DefaultClientID: true}.entityComponents()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entity) != 2 || entity[0].EntityType != sarama.QuotaEntityUser || entity[0].Name != "alice" ||
+		entity[1].EntityType != sarama.QuotaEntityClientID || entity[1].MatchType != sarama.QuotaMatchDefault {
+		t.Fatalf("unexpected entity: %v", entity)
+	}
+
+	if _, err := (EntityFlags{}).entityComponents(); err == nil {
+		t.Fatalf("expected error without user or client-id")
+	}
+
+	if _, err := (EntityFlags{User: "alice", DefaultUser: true}).entityComponents(); err == nil {
+		t.Fatalf("expected error when combining --user and --default-user")
+	}
+}
+
+func TestAlterQuotaValues(t *testing.T) {
+
+	values, err := AlterQuotaFlags{ProducerByteRate: "10MB", ConsumerByteRate: "1024", RequestPercentage: "12.5"}.values()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if values[ProducerByteRate] != 10000000 || values[ConsumerByteRate] != 1024 || values[RequestPercentage] != 12.5 {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	if _, ok := values[ControllerMutationRate]; ok {
+		t.Fatalf("controller mutation rate should not be set: %v", values)
+	}
+
+	if _, err := (AlterQuotaFlags{RequestPercentage: "abc"}).values(); err == nil {
+		t.Fatalf("expected error for invalid request percentage")
+	}
+}
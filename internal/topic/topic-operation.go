@@ -50,6 +50,7 @@ var allFields = requestedTopicFields{
 }
 
 type GetTopicsFlags struct {
+	SelectTopicsFlags
 	OutputFormat string
 }
 
@@ -62,12 +63,17 @@ type CreateTopicFlags struct {
 }
 
 type AlterTopicFlags struct {
+	SelectTopicsFlags
 	Partitions        int32
 	ReplicationFactor int16
 	ValidateOnly      bool
 	Configs           []string
 }
 
+type DeleteTopicsFlags struct {
+	SelectTopicsFlags
+}
+
 type DeleteRecordsFlags struct {
 	Offsets []string
 }
@@ -81,6 +87,7 @@ const (
 )
 
 type DescribeTopicFlags struct {
+	SelectTopicsFlags
 	PrintConfigs        PrintConfigsParam
 	AllConfigs          bool
 	SkipEmptyPartitions bool
@@ -169,11 +176,12 @@ func (operation *Operation) CreateTopics(topics []string, flags CreateTopicFlags
 	return nil
 }
 
-func (operation *Operation) DeleteTopics(topics []string) error {
+func (operation *Operation) DeleteTopics(topics []string, flags DeleteTopicsFlags) error {
 
 	var (
 		err     error
 		context internal.ClientContext
+		client  sarama.Client
 		admin   sarama.ClusterAdmin
 	)
 
@@ -181,6 +189,21 @@ func (operation *Operation) DeleteTopics(topics []string) error {
 		return err
 	}
 
+	if IsPatternSelection(topics, flags.SelectTopicsFlags) {
+		if client, err = internal.CreateClient(&context); err != nil {
+			return errors.Wrap(err, "failed to create client")
+		}
+		defer client.Close()
+
+		if topics, err = SelectTopics(client, topics, flags.SelectTopicsFlags); err != nil {
+			return err
+		}
+
+		if err = ConfirmTopicSelection("deleted", topics); err != nil {
+			return err
+		}
+	}
+
 	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
 		return errors.Wrap(err, "failed to create cluster admin")
 	}
@@ -194,6 +217,75 @@ func (operation *Operation) DeleteTopics(topics []string) error {
 	return nil
 }
 
+// DescribeTopics describes all topics matching the given names, glob patterns and the pattern of the flags.
+func (operation *Operation) DescribeTopics(topics []string, flags DescribeTopicFlags) error {
+
+	if len(topics) == 1 && !IsPatternSelection(topics, flags.SelectTopicsFlags) {
+		return operation.DescribeTopic(topics[0], flags)
+	}
+
+	var (
+		context internal.ClientContext
+		client  sarama.Client
+		admin   sarama.ClusterAdmin
+		err     error
+	)
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if topics, err = SelectTopics(client, topics, flags.SelectTopicsFlags); err != nil {
+		return err
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+
+	fields := allFields
+
+	if flags.AllConfigs {
+		fields.config = AllConfigs
+	} else {
+		fields.config = NonDefaultConfigs
+	}
+
+	topicList := make([]Topic, 0, len(topics))
+
+	for _, topic := range topics {
+		t, err := readTopic(&client, &admin, topic, fields)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read topic %s", topic)
+		}
+		topicList = append(topicList, t)
+	}
+
+	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
+		for i := range topicList {
+			topicList[i] = filterTopic(topicList[i], flags)
+		}
+		return output.PrintObject(topicList, flags.OutputFormat)
+	}
+
+	for i, t := range topicList {
+		if i > 0 {
+			output.Infof("")
+		}
+		output.Infof("TOPIC: %s", t.Name)
+		if err := operation.printTopic(t, flags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (operation *Operation) DescribeTopic(topic string, flags DescribeTopicFlags) error {
 
 	var (
@@ -240,7 +332,8 @@ func (operation *Operation) DescribeTopic(topic string, flags DescribeTopicFlags
 	return operation.printTopic(t, flags)
 }
 
-func (operation *Operation) printTopic(topic Topic, flags DescribeTopicFlags) error {
+// filterTopic removes configs and partitions from the topic which should not be printed.
+func filterTopic(topic Topic, flags DescribeTopicFlags) Topic {
 
 	if flags.PrintConfigs == NoConfigs {
 		topic.Configs = nil
@@ -252,6 +345,23 @@ func (operation *Operation) printTopic(topic Topic, flags DescribeTopicFlags) er
 		})
 	}
 
+	if flags.SkipEmptyPartitions {
+		partitionsWithMessages := make([]Partition, 0)
+		for _, p := range topic.Partitions {
+			if p.OldestOffset < p.NewestOffset {
+				partitionsWithMessages = append(partitionsWithMessages, p)
+			}
+		}
+		topic.Partitions = partitionsWithMessages
+	}
+
+	return topic
+}
+
+func (operation *Operation) printTopic(topic Topic, flags DescribeTopicFlags) error {
+
+	topic = filterTopic(topic, flags)
+
 	if len(topic.Configs) != 0 && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
 		configTableWriter := output.CreateTableWriter()
 		if err := configTableWriter.WriteHeader("CONFIG", "VALUE"); err != nil {
@@ -270,16 +380,6 @@ func (operation *Operation) printTopic(topic Topic, flags DescribeTopicFlags) er
 		output.PrintStrings("")
 	}
 
-	if flags.SkipEmptyPartitions {
-		partitionsWithMessages := make([]Partition, 0)
-		for _, p := range topic.Partitions {
-			if p.OldestOffset < p.NewestOffset {
-				partitionsWithMessages = append(partitionsWithMessages, p)
-			}
-		}
-		topic.Partitions = partitionsWithMessages
-	}
-
 	partitionTableWriter := output.CreateTableWriter()
 
 	if flags.OutputFormat == "" || flags.OutputFormat == "wide" {
@@ -312,6 +412,52 @@ func (operation *Operation) printTopic(topic Topic, flags DescribeTopicFlags) er
 	return nil
 }
 
+// AlterTopics alters all topics matching the given names, glob patterns and the pattern of the flags.
+// When topics are selected by patterns, the user has to confirm the list of matching topics.
+func (operation *Operation) AlterTopics(topics []string, flags AlterTopicFlags) error {
+
+	// checked before the patterns are resolved to the names of the topics
+	patternSelection := IsPatternSelection(topics, flags.SelectTopicsFlags)
+
+	if len(topics) == 1 && !patternSelection {
+		return operation.AlterTopic(topics[0], flags)
+	}
+
+	var (
+		context internal.ClientContext
+		client  sarama.Client
+		err     error
+	)
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if topics, err = SelectTopics(client, topics, flags.SelectTopicsFlags); err != nil {
+		return err
+	}
+
+	if patternSelection && !flags.ValidateOnly {
+		if err = ConfirmTopicSelection("altered", topics); err != nil {
+			return err
+		}
+	}
+
+	for _, topic := range topics {
+		output.Infof("altering topic: %s", topic)
+		if err = operation.AlterTopic(topic, flags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (operation *Operation) AlterTopic(topic string, flags AlterTopicFlags) error {
 
 	var (
@@ -693,7 +839,7 @@ func getTargetReplicas(currentReplicas []int32, brokerReplicaCount map[int32]int
 	return replicas, nil
 }
 
-func (operation *Operation) GetTopics(flags GetTopicsFlags) error {
+func (operation *Operation) GetTopics(names []string, flags GetTopicsFlags) error {
 
 	var (
 		err     error
@@ -715,8 +861,8 @@ func (operation *Operation) GetTopics(flags GetTopicsFlags) error {
 		return errors.Wrap(err, "failed to create client")
 	}
 
-	if topics, err = client.Topics(); err != nil {
-		return errors.Wrap(err, "failed to read topics")
+	if topics, err = SelectTopics(client, names, flags.SelectTopicsFlags); err != nil {
+		return err
 	}
 
 	tableWriter := output.CreateTableWriter()
@@ -742,33 +888,7 @@ func (operation *Operation) GetTopics(flags GetTopicsFlags) error {
 		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
 	}
 
-	topicChannel := make(chan Topic)
-	errChannel := make(chan error)
-
-	// read topics in parallel
-	for _, topic := range topics {
-		go func(topic string) {
-			t, err := readTopic(&client, &admin, topic, requestedFields)
-			if err != nil {
-				output.Debugf("failed to read topic %q: %v", topic, err)
-			}
-			topicChannel <- t
-		}(topic)
-	}
-
-	topicList := make([]Topic, 0, len(topics))
-	for range topics {
-		select {
-		case topic := <-topicChannel:
-			topicList = append(topicList, topic)
-		case err := <-errChannel:
-			return err
-		}
-	}
-
-	sort.Slice(topicList, func(i, j int) bool {
-		return topicList[i].Name < topicList[j].Name
-	})
+	topicList := readTopics(&client, &admin, topics, requestedFields)
 
 	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
 		return output.PrintObject(topicList, flags.OutputFormat)
@@ -824,6 +944,40 @@ func (operation *Operation) DeleteRecords(topic string, flags DeleteRecordsFlags
 	return admin.DeleteRecords(topic, offsets)
 }
 
+// ReadTopics reads the given topics including partition offsets, leaders and replicas.
+func ReadTopics(client *sarama.Client, admin *sarama.ClusterAdmin, topics []string) []Topic {
+	return readTopics(client, admin, topics, requestedTopicFields{
+		partitionID: true, partitionOffset: true, partitionLeader: true, partitionReplicas: true, partitionISRs: true,
+	})
+}
+
+// readTopics reads topics in parallel. The returned list is sorted by name.
+func readTopics(client *sarama.Client, admin *sarama.ClusterAdmin, topics []string, requestedFields requestedTopicFields) []Topic {
+
+	topicChannel := make(chan Topic)
+
+	for _, topic := range topics {
+		go func(topic string) {
+			t, err := readTopic(client, admin, topic, requestedFields)
+			if err != nil {
+				output.Debugf("failed to read topic %q: %v", topic, err)
+			}
+			topicChannel <- t
+		}(topic)
+	}
+
+	topicList := make([]Topic, 0, len(topics))
+	for range topics {
+		topicList = append(topicList, <-topicChannel)
+	}
+
+	sort.Slice(topicList, func(i, j int) bool {
+		return topicList[i].Name < topicList[j].Name
+	})
+
+	return topicList
+}
+
 func readTopic(client *sarama.Client, admin *sarama.ClusterAdmin, name string, requestedFields requestedTopicFields) (Topic, error) {
 	var (
 		err error
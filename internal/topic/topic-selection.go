@@ -0,0 +1,159 @@
+package topic
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal/global"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// SelectTopicsFlags selects topics in addition to the topic names and glob patterns given as arguments.
+type SelectTopicsFlags struct {
+	Pattern         string
+	ExcludeInternal bool
+}
+
+// IsPatternSelection returns true if topics are not only selected by their literal names.
+func IsPatternSelection(names []string, flags SelectTopicsFlags) bool {
+	if flags.Pattern != "" {
+		return true
+	}
+	for _, name := range names {
+		if isGlob(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectTopics resolves topic names, glob patterns and the regular expression of the flags to a
+// sorted list of existing topics. Literal topic names must exist, patterns must match at least one topic.
+// Without names and pattern all topics are selected. Internal topics (starting with `__`) are excluded if requested.
+func SelectTopics(client sarama.Client, names []string, flags SelectTopicsFlags) ([]string, error) {
+
+	existingTopics, err := client.Topics()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read topics")
+	}
+
+	return selectTopics(existingTopics, names, flags)
+}
+
+func selectTopics(existingTopics []string, names []string, flags SelectTopicsFlags) ([]string, error) {
+
+	matched := make(map[string]struct{})
+
+	if len(names) == 0 && flags.Pattern == "" {
+		for _, topic := range existingTopics {
+			if !flags.ExcludeInternal || !isInternalTopic(topic) {
+				matched[topic] = struct{}{}
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !isGlob(name) {
+			if !util.ContainsString(existingTopics, name) {
+				return nil, errors.Errorf("topic '%s' does not exist", name)
+			}
+			matched[name] = struct{}{}
+			continue
+		}
+
+		topicGlob, err := glob.Compile(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid topic pattern: %s", name)
+		}
+
+		if err := matchTopics(existingTopics, name, topicGlob.Match, flags, matched); err != nil {
+			return nil, err
+		}
+	}
+
+	if flags.Pattern != "" {
+		// the pattern has to match the whole topic name like in kafka-topics.sh
+		regex, err := regexp.Compile("^(?:" + flags.Pattern + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid topic pattern: %s", flags.Pattern)
+		}
+
+		if err := matchTopics(existingTopics, flags.Pattern, regex.MatchString, flags, matched); err != nil {
+			return nil, err
+		}
+	}
+
+	topics := make([]string, 0, len(matched))
+	for topic := range matched {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	output.Debugf("selected topics: %v", topics)
+
+	return topics, nil
+}
+
+func matchTopics(existingTopics []string, pattern string, match func(string) bool, flags SelectTopicsFlags, matched map[string]struct{}) error {
+	found := false
+	for _, topic := range existingTopics {
+		if flags.ExcludeInternal && isInternalTopic(topic) {
+			continue
+		}
+		if match(topic) {
+			matched[topic] = struct{}{}
+			found = true
+		}
+	}
+
+	if !found {
+		return errors.Errorf("no topic matches pattern '%s'", pattern)
+	}
+	return nil
+}
+
+func isGlob(name string) bool {
+	return strings.ContainsAny(name, "*?[{")
+}
+
+func isInternalTopic(name string) bool {
+	return strings.HasPrefix(name, "__")
+}
+
+// ConfirmTopicSelection prints the selected topics and asks for confirmation, unless skipped with --yes.
+func ConfirmTopicSelection(action string, topics []string) error {
+
+	output.Warnf("the following topics will be %s:", action)
+	for _, topic := range topics {
+		output.Warnf("  %s", topic)
+	}
+
+	if global.GetFlags().SkipConfirmation {
+		return nil
+	}
+
+	confirmed, err := output.Confirm("continue?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("aborted")
+	}
+	return nil
+}
+
+// TopicsOrPattern requires at least one topic argument unless topics are selected with --pattern.
+func TopicsOrPattern(flags *SelectTopicsFlags) cobra.PositionalArgs {
+	return func(_ *cobra.Command, args []string) error {
+		if len(args) == 0 && flags.Pattern == "" {
+			return errors.New("requires at least one topic or --pattern")
+		}
+		return nil
+	}
+}
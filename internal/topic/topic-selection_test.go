@@ -0,0 +1,77 @@
+package topic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var existingTopics = []string{"__consumer_offsets", "orders-eu", "orders-us", "payments", "payments-dlq"}
+
+func TestSelectTopics(t *testing.T) {
+
+	tests := []struct {
+		description string
+		names       []string
+		flags       SelectTopicsFlags
+		expected    []string
+	}{
+		{"all topics", nil, SelectTopicsFlags{}, existingTopics},
+		{"all topics without internal", nil, SelectTopicsFlags{ExcludeInternal: true}, existingTopics[1:]},
+		{"literal names", []string{"payments", "orders-eu"}, SelectTopicsFlags{}, []string{"orders-eu", "payments"}},
+		{"glob", []string{"orders-*"}, SelectTopicsFlags{}, []string{"orders-eu", "orders-us"}},
+		{"glob and literal name", []string{"orders-*", "payments"}, SelectTopicsFlags{}, []string{"orders-eu", "orders-us", "payments"}},
+		{"regex matches whole name", nil, SelectTopicsFlags{Pattern: "payments"}, []string{"payments"}},
+		{"regex", nil, SelectTopicsFlags{Pattern: ".*-(eu|dlq)"}, []string{"orders-eu", "payments-dlq"}},
+		{"regex without internal", nil, SelectTopicsFlags{Pattern: ".*s", ExcludeInternal: true}, []string{"orders-us", "payments"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			topics, err := selectTopics(existingTopics, test.names, test.flags)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(topics, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, topics)
+			}
+		})
+	}
+}
+
+func TestSelectTopicsErrors(t *testing.T) {
+
+	tests := []struct {
+		description string
+		names       []string
+		flags       SelectTopicsFlags
+		expectedErr string
+	}{
+		{"unknown topic", []string{"unknown"}, SelectTopicsFlags{}, "topic 'unknown' does not exist"},
+		{"glob without match", []string{"invoices-*"}, SelectTopicsFlags{}, "no topic matches pattern 'invoices-*'"},
+		{"regex without match", nil, SelectTopicsFlags{Pattern: "__.*", ExcludeInternal: true}, "no topic matches pattern '__.*'"},
+		{"invalid regex", nil, SelectTopicsFlags{Pattern: "orders-("}, "invalid topic pattern: orders-("},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, err := selectTopics(existingTopics, test.names, test.flags)
+			if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+				t.Fatalf("expected error containing %q, got: %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestIsPatternSelection(t *testing.T) {
+
+	if IsPatternSelection([]string{"orders-eu", "payments"}, SelectTopicsFlags{}) {
+		t.Errorf("literal names are no pattern selection")
+	}
+	if !IsPatternSelection([]string{"orders-*"}, SelectTopicsFlags{}) {
+		t.Errorf("glob is a pattern selection")
+	}
+	if !IsPatternSelection(nil, SelectTopicsFlags{Pattern: "orders-.*"}) {
+		t.Errorf("regex is a pattern selection")
+	}
+}
@@ -0,0 +1,206 @@
+package topic
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/broker"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+	"github.com/pkg/errors"
+)
+
+type GetTopicSizesFlags struct {
+	SelectTopicsFlags
+	SortBy       string
+	OutputFormat string
+}
+
+type TopicSize struct {
+	Name       string
+	Partitions int
+	Replicas   int
+	Size       int64
+	// PartitionReplicas are only part of wide, json and yaml output
+	PartitionReplicas []broker.ReplicaSize `json:"partitionReplicas,omitempty" yaml:"partitionReplicas,omitempty"`
+}
+
+type BrokerSize struct {
+	Broker int32
+	Size   int64
+}
+
+type TopicSizes struct {
+	Topics  []TopicSize
+	Brokers []BrokerSize
+	Total   int64
+}
+
+// GetTopicSizes prints the disk usage of topics summed up over all partition replicas with totals per broker.
+func (operation *Operation) GetTopicSizes(names []string, flags GetTopicSizesFlags) error {
+
+	var (
+		err     error
+		context internal.ClientContext
+		client  sarama.Client
+		admin   sarama.ClusterAdmin
+		topics  []string
+	)
+
+	if flags.OutputFormat != "" && flags.OutputFormat != "wide" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		return errors.Errorf("unknown outputFormat: %s", flags.OutputFormat)
+	}
+
+	if flags.SortBy != "size" && flags.SortBy != "name" {
+		return errors.Errorf("unknown sort order: %s (size|name)", flags.SortBy)
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	if client, err = internal.CreateClient(&context); err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+	defer client.Close()
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	if topics, err = SelectTopics(client, names, flags.SelectTopicsFlags); err != nil {
+		return err
+	}
+
+	brokerIDs := make([]int32, 0)
+	for _, b := range client.Brokers() {
+		brokerIDs = append(brokerIDs, b.ID())
+	}
+
+	logDirs, err := broker.ReadLogDirs(admin, brokerIDs)
+	if err != nil {
+		return err
+	}
+
+	sizes := topicSizes(topics, logDirs, flags.SortBy)
+
+	if flags.OutputFormat != "wide" && flags.OutputFormat != "json" && flags.OutputFormat != "yaml" {
+		for i := range sizes.Topics {
+			sizes.Topics[i].PartitionReplicas = nil
+		}
+	}
+
+	if flags.OutputFormat == "json" || flags.OutputFormat == "yaml" {
+		return output.PrintObject(sizes, flags.OutputFormat)
+	}
+
+	return printTopicSizes(sizes, flags.OutputFormat == "wide")
+}
+
+func topicSizes(topics []string, logDirs []broker.LogDir, sortBy string) TopicSizes {
+
+	topicsByName := make(map[string]*TopicSize)
+	for _, name := range topics {
+		topicsByName[name] = &TopicSize{Name: name, PartitionReplicas: make([]broker.ReplicaSize, 0)}
+	}
+
+	partitions := make(map[string]map[int32]bool)
+	brokerSizes := make(map[int32]int64)
+	sizes := TopicSizes{Topics: make([]TopicSize, 0, len(topics)), Brokers: make([]BrokerSize, 0)}
+
+	for _, logDir := range logDirs {
+		if _, ok := brokerSizes[logDir.Broker]; !ok {
+			brokerSizes[logDir.Broker] = 0
+		}
+		for _, replica := range logDir.Replicas {
+			topic, ok := topicsByName[replica.Topic]
+			if !ok {
+				continue
+			}
+			if partitions[replica.Topic] == nil {
+				partitions[replica.Topic] = make(map[int32]bool)
+			}
+			partitions[replica.Topic][replica.Partition] = true
+			topic.Replicas++
+			topic.Size += replica.Size
+			topic.PartitionReplicas = append(topic.PartitionReplicas, replica)
+			brokerSizes[replica.Broker] += replica.Size
+			sizes.Total += replica.Size
+		}
+	}
+
+	for _, topic := range topicsByName {
+		topic.Partitions = len(partitions[topic.Name])
+		broker.SortReplicasBySize(topic.PartitionReplicas)
+		sizes.Topics = append(sizes.Topics, *topic)
+	}
+
+	sort.Slice(sizes.Topics, func(i, j int) bool {
+		if sortBy == "size" && sizes.Topics[i].Size != sizes.Topics[j].Size {
+			return sizes.Topics[i].Size > sizes.Topics[j].Size
+		}
+		return sizes.Topics[i].Name < sizes.Topics[j].Name
+	})
+
+	for id, size := range brokerSizes {
+		sizes.Brokers = append(sizes.Brokers, BrokerSize{Broker: id, Size: size})
+	}
+
+	sort.Slice(sizes.Brokers, func(i, j int) bool { return sizes.Brokers[i].Broker < sizes.Brokers[j].Broker })
+
+	return sizes
+}
+
+func printTopicSizes(sizes TopicSizes, wide bool) error {
+
+	tableWriter := output.CreateTableWriter()
+
+	if wide {
+		if err := tableWriter.WriteHeader("TOPIC", "PARTITION", "BROKER", "LOG_DIR", "SIZE"); err != nil {
+			return err
+		}
+		for _, topic := range sizes.Topics {
+			for _, replica := range topic.PartitionReplicas {
+				if err := tableWriter.Write(topic.Name, strconv.Itoa(int(replica.Partition)), strconv.Itoa(int(replica.Broker)),
+					replica.LogDir, util.FormatSize(replica.Size)); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		if err := tableWriter.WriteHeader("TOPIC", "PARTITIONS", "REPLICAS", "SIZE"); err != nil {
+			return err
+		}
+		for _, topic := range sizes.Topics {
+			if err := tableWriter.Write(topic.Name, strconv.Itoa(topic.Partitions), strconv.Itoa(topic.Replicas),
+				util.FormatSize(topic.Size)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tableWriter.Flush(); err != nil {
+		return err
+	}
+
+	output.PrintStrings("")
+
+	if err := tableWriter.WriteHeader("BROKER", "SIZE"); err != nil {
+		return err
+	}
+
+	for _, b := range sizes.Brokers {
+		if err := tableWriter.Write(strconv.Itoa(int(b.Broker)), util.FormatSize(b.Size)); err != nil {
+			return err
+		}
+	}
+
+	if err := tableWriter.Write("TOTAL", util.FormatSize(sizes.Total)); err != nil {
+		return err
+	}
+
+	return tableWriter.Flush()
+}
@@ -0,0 +1,43 @@
+package topic
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/broker"
+)
+
+func TestTopicSizes(t *testing.T) {
+
+	logDirs := []broker.LogDir{
+		{Broker: 1, Path: "/data", Replicas: []broker.ReplicaSize{
+			{Topic: "a", Partition: 0, Broker: 1, Size: 10},
+			{Topic: "b", Partition: 0, Broker: 1, Size: 100},
+			{Topic: "c", Partition: 0, Broker: 1, Size: 1000},
+		}},
+		{Broker: 2, Path: "/data", Replicas: []broker.ReplicaSize{
+			{Topic: "a", Partition: 0, Broker: 2, Size: 10},
+			{Topic: "a", Partition: 1, Broker: 2, Size: 5},
+		}},
+	}
+
+	sizes := topicSizes([]string{"a", "b"}, logDirs, "size")
+
+	if len(sizes.Topics) != 2 || sizes.Topics[0].Name != "b" || sizes.Topics[1].Name != "a" {
+		t.Fatalf("expected topics sorted by size: %v", sizes.Topics)
+	}
+
+	a := sizes.Topics[1]
+	if a.Size != 25 || a.Partitions != 2 || a.Replicas != 3 {
+		t.Fatalf("unexpected size of topic a: %v", a)
+	}
+
+	if sizes.Total != 125 || len(sizes.Brokers) != 2 || sizes.Brokers[0].Size != 110 || sizes.Brokers[1].Size != 15 {
+		t.Fatalf("unexpected totals: %d %v", sizes.Total, sizes.Brokers)
+	}
+
+	byName := topicSizes([]string{"a", "b"}, logDirs, "name")
+
+	if byName.Topics[0].Name != "a" {
+		t.Fatalf("expected topics sorted by name: %v", byName.Topics)
+	}
+}
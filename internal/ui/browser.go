@@ -0,0 +1,506 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+)
+
+const maxColumnWidth = 80
+
+const (
+	pageTopics       = "topics"
+	pagePartitions   = "partitions"
+	pageMessages     = "messages"
+	pageMessage      = "message"
+	pageGroups       = "groups"
+	pageGroupOffsets = "group-offsets"
+	pagePrompt       = "prompt"
+)
+
+type browser struct {
+	source   dataSource
+	pageSize int
+
+	app    *tview.Application
+	pages  *tview.Pages
+	status *tview.TextView
+
+	topicsTable       *tview.Table
+	partitionsTable   *tview.Table
+	messagesTable     *tview.Table
+	messageView       *tview.TextView
+	groupsTable       *tview.Table
+	groupOffsetsTable *tview.Table
+
+	topics    []topic.Topic
+	topic     topic.Topic
+	partition topic.Partition
+	pageStart int64
+	messages  []*consume.Message
+	groups    []string
+}
+
+func newBrowser(source dataSource, pageSize int) *browser {
+
+	b := &browser{
+		source:   source,
+		pageSize: pageSize,
+		app:      tview.NewApplication(),
+		pages:    tview.NewPages(),
+		status:   tview.NewTextView().SetDynamicColors(true),
+	}
+
+	b.topicsTable = b.newTable("Topics")
+	b.partitionsTable = b.newTable("Partitions")
+	b.messagesTable = b.newTable("Messages")
+	b.groupsTable = b.newTable("Consumer Groups")
+	b.groupOffsetsTable = b.newTable("Consumer Group Offsets")
+
+	b.messageView = tview.NewTextView().SetDynamicColors(false).SetScrollable(true).SetWrap(true)
+	b.messageView.SetBorder(true).SetTitle("Message")
+
+	b.topicsTable.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.topics) {
+			b.showPartitions(b.topics[row-1].Name)
+		}
+	})
+	b.partitionsTable.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.topic.Partitions) {
+			b.partition = b.topic.Partitions[row-1]
+			b.showMessages(b.partition.OldestOffset)
+		}
+	})
+	b.messagesTable.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.messages) {
+			b.showMessage(b.messages[row-1])
+		}
+	})
+	b.groupsTable.SetSelectedFunc(func(row, _ int) {
+		if row > 0 && row <= len(b.groups) {
+			b.showGroupOffsets(b.groups[row-1])
+		}
+	})
+
+	b.topicsTable.SetInputCapture(b.keyHandler(map[rune]func(){
+		'g': b.showGroups,
+		'r': b.showTopics,
+	}, nil))
+	b.partitionsTable.SetInputCapture(b.keyHandler(map[rune]func(){
+		'g': b.showGroups,
+		'r': func() { b.showPartitions(b.topic.Name) },
+	}, func() { b.pages.SwitchToPage(pageTopics); b.updateStatus(pageTopics) }))
+	b.messagesTable.SetInputCapture(b.keyHandler(map[rune]func(){
+		'n': b.nextPage,
+		'p': b.previousPage,
+		'o': b.promptOffset,
+		't': b.promptTimestamp,
+		'r': func() { b.showMessages(b.pageStart) },
+	}, func() { b.showPartitions(b.topic.Name) }))
+	b.messageView.SetInputCapture(b.keyHandler(nil, func() { b.pages.SwitchToPage(pageMessages); b.updateStatus(pageMessages) }))
+	b.groupsTable.SetInputCapture(b.keyHandler(map[rune]func(){
+		'r': b.showGroups,
+	}, func() { b.pages.SwitchToPage(pageTopics); b.updateStatus(pageTopics) }))
+	b.groupOffsetsTable.SetInputCapture(b.keyHandler(nil, func() { b.pages.SwitchToPage(pageGroups); b.updateStatus(pageGroups) }))
+
+	b.pages.AddPage(pageTopics, b.topicsTable, true, true)
+	b.pages.AddPage(pagePartitions, b.partitionsTable, true, false)
+	b.pages.AddPage(pageMessages, b.messagesTable, true, false)
+	b.pages.AddPage(pageMessage, b.messageView, true, false)
+	b.pages.AddPage(pageGroups, b.groupsTable, true, false)
+	b.pages.AddPage(pageGroupOffsets, b.groupOffsetsTable, true, false)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(b.pages, 0, 1, true).
+		AddItem(b.status, 1, 0, false)
+
+	b.app.SetRoot(layout, true)
+
+	return b
+}
+
+func (b *browser) run() error {
+	b.showTopics()
+	return b.app.Run()
+}
+
+func (b *browser) newTable(title string) *tview.Table {
+	table := tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	table.SetBorder(true).SetTitle(title)
+	return table
+}
+
+// keyHandler creates an input handler for the given shortcuts. back is called for ESC.
+func (b *browser) keyHandler(shortcuts map[rune]func(), back func()) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			if back != nil {
+				back()
+			}
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				b.app.Stop()
+				return nil
+			}
+			if action, ok := shortcuts[event.Rune()]; ok {
+				action()
+				return nil
+			}
+		}
+		return event
+	}
+}
+
+func (b *browser) updateStatus(page string) {
+	hints := map[string]string{
+		pageTopics:       "enter: partitions  g: consumer groups  r: refresh  q: quit",
+		pagePartitions:   "enter: browse messages  g: consumer groups  r: refresh  esc: back  q: quit",
+		pageMessages:     "enter: details  n: next page  p: previous page  o: goto offset  t: goto timestamp  esc: back  q: quit",
+		pageMessage:      "esc: back  q: quit",
+		pageGroups:       "enter: offsets  r: refresh  esc: back  q: quit",
+		pageGroupOffsets: "esc: back  q: quit",
+	}
+	b.status.SetText(hints[page])
+}
+
+func (b *browser) showError(err error) {
+	b.status.SetText("[red]" + tview.Escape(err.Error()))
+}
+
+// load executes the given function in the background and applies the result on the ui thread.
+func (b *browser) load(fetch func() (func(), error)) {
+	b.status.SetText("loading...")
+	go func() {
+		apply, err := fetch()
+		b.app.QueueUpdateDraw(func() {
+			if err != nil {
+				b.showError(err)
+				return
+			}
+			apply()
+		})
+	}()
+}
+
+func (b *browser) showTopics() {
+	b.load(func() (func(), error) {
+		topics, err := b.source.Topics()
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			b.topics = topics
+			fillTable(b.topicsTable, topicsRows(topics))
+			b.pages.SwitchToPage(pageTopics)
+			b.updateStatus(pageTopics)
+		}, nil
+	})
+}
+
+func (b *browser) showPartitions(topicName string) {
+	b.load(func() (func(), error) {
+		t, err := b.source.Topic(topicName)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			b.topic = t
+			b.partitionsTable.SetTitle("Partitions of " + t.Name)
+			fillTable(b.partitionsTable, partitionsRows(t))
+			b.pages.SwitchToPage(pagePartitions)
+			b.updateStatus(pagePartitions)
+		}, nil
+	})
+}
+
+func (b *browser) showMessages(offset int64) {
+	topicName, partition := b.topic.Name, b.partition.ID
+	b.load(func() (func(), error) {
+		messages, err := b.source.Messages(topicName, partition, offset, b.pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			b.pageStart = offset
+			b.messages = messages
+			b.messagesTable.SetTitle(fmt.Sprintf("Messages of %s partition %d (offset %d)", topicName, partition, offset))
+			fillTable(b.messagesTable, messagesRows(messages))
+			b.pages.SwitchToPage(pageMessages)
+			b.updateStatus(pageMessages)
+		}, nil
+	})
+}
+
+func (b *browser) nextPage() {
+	if len(b.messages) > 0 {
+		b.showMessages(nextPageOffset(b.messages, b.pageStart))
+	}
+}
+
+func (b *browser) previousPage() {
+	b.showMessages(previousPageOffset(b.pageStart, b.pageSize, b.partition.OldestOffset))
+}
+
+func (b *browser) promptOffset() {
+	b.prompt("Offset", func(value string) {
+		offset, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			b.showError(fmt.Errorf("invalid offset: %s", value))
+			return
+		}
+		b.showMessages(offset)
+	})
+}
+
+func (b *browser) promptTimestamp() {
+	topicName, partition := b.topic.Name, b.partition.ID
+	b.prompt("Timestamp", func(value string) {
+		timestamp, err := util.ParseTimestamp(strings.TrimSpace(value))
+		if err != nil {
+			b.showError(err)
+			return
+		}
+		b.load(func() (func(), error) {
+			offset, err := b.source.OffsetForTimestamp(topicName, partition, timestamp.UnixMilli())
+			if err != nil {
+				return nil, err
+			}
+			if offset < 0 {
+				return nil, fmt.Errorf("no messages after %s", timestamp.Format(time.RFC3339))
+			}
+			return func() { b.showMessages(offset) }, nil
+		})
+	})
+}
+
+func (b *browser) prompt(label string, onDone func(value string)) {
+	input := tview.NewInputField().SetLabel(label + ": ").SetFieldWidth(30)
+	input.SetBorder(true)
+	input.SetDoneFunc(func(key tcell.Key) {
+		b.pages.RemovePage(pagePrompt)
+		if key == tcell.KeyEnter {
+			onDone(input.GetText())
+		}
+	})
+
+	modal := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(input, 3, 0, true).
+			AddItem(nil, 0, 1, false), 50, 0, true).
+		AddItem(nil, 0, 1, false)
+
+	b.pages.AddPage(pagePrompt, modal, true, true)
+}
+
+func (b *browser) showMessage(msg *consume.Message) {
+	b.messageView.SetText(formatMessage(msg))
+	b.messageView.ScrollToBeginning()
+	b.pages.SwitchToPage(pageMessage)
+	b.updateStatus(pageMessage)
+}
+
+func (b *browser) showGroups() {
+	b.load(func() (func(), error) {
+		groups, err := b.source.ConsumerGroups()
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			b.groups = groups
+			rows := [][]string{{"CONSUMER_GROUP"}}
+			for _, group := range groups {
+				rows = append(rows, []string{group})
+			}
+			fillTable(b.groupsTable, rows)
+			b.pages.SwitchToPage(pageGroups)
+			b.updateStatus(pageGroups)
+		}, nil
+	})
+}
+
+func (b *browser) showGroupOffsets(group string) {
+	b.load(func() (func(), error) {
+		offsets, err := b.source.ConsumerGroupOffsets(group)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			b.groupOffsetsTable.SetTitle(fmt.Sprintf("Offsets of %s (total lag %d)", group, totalLag(offsets)))
+			fillTable(b.groupOffsetsTable, groupOffsetsRows(offsets))
+			b.pages.SwitchToPage(pageGroupOffsets)
+			b.updateStatus(pageGroupOffsets)
+		}, nil
+	})
+}
+
+func fillTable(table *tview.Table, rows [][]string) {
+	table.Clear()
+	for r, row := range rows {
+		for c, value := range row {
+			cell := tview.NewTableCell(tview.Escape(truncate(value, maxColumnWidth))).SetExpansion(1)
+			if r == 0 {
+				cell.SetSelectable(false).SetAttributes(tcell.AttrBold)
+			}
+			table.SetCell(r, c, cell)
+		}
+	}
+	table.ScrollToBeginning()
+	if len(rows) > 1 {
+		table.Select(1, 0)
+	}
+}
+
+func topicsRows(topics []topic.Topic) [][]string {
+	rows := [][]string{{"TOPIC", "PARTITIONS", "REPLICATION FACTOR", "MESSAGES"}}
+	for _, t := range topics {
+		var messages int64
+		for _, p := range t.Partitions {
+			messages += p.NewestOffset - p.OldestOffset
+		}
+		rows = append(rows, []string{t.Name, strconv.Itoa(len(t.Partitions)), strconv.Itoa(t.ReplicationFactor), strconv.FormatInt(messages, 10)})
+	}
+	return rows
+}
+
+func partitionsRows(t topic.Topic) [][]string {
+	rows := [][]string{{"PARTITION", "OLDEST_OFFSET", "NEWEST_OFFSET", "MESSAGES", "LEADER", "REPLICAS", "IN_SYNC_REPLICAS"}}
+	for _, p := range t.Partitions {
+		rows = append(rows, []string{
+			strconv.Itoa(int(p.ID)), strconv.FormatInt(p.OldestOffset, 10), strconv.FormatInt(p.NewestOffset, 10),
+			strconv.FormatInt(p.NewestOffset-p.OldestOffset, 10), p.Leader, joinInt32(p.Replicas), joinInt32(p.ISRs),
+		})
+	}
+	return rows
+}
+
+func messagesRows(messages []*consume.Message) [][]string {
+	rows := [][]string{{"OFFSET", "TIMESTAMP", "KEY", "VALUE"}}
+	for _, msg := range messages {
+		rows = append(rows, []string{strconv.FormatInt(msg.Offset, 10), formatTimestamp(msg.Timestamp), stringOrNull(msg.Key), stringOrNull(msg.Value)})
+	}
+	return rows
+}
+
+func groupOffsetsRows(offsets []consumergroups.TopicPartitionOffsets) [][]string {
+	rows := [][]string{{"TOPIC", "PARTITION", "NEWEST_OFFSET", "OLDEST_OFFSET", "CONSUMER_OFFSET", "LAG"}}
+	for _, t := range offsets {
+		for _, p := range t.Partitions {
+			rows = append(rows, []string{
+				t.Name, strconv.Itoa(int(p.Partition)), strconv.FormatInt(p.NewestOffset, 10), strconv.FormatInt(p.OldestOffset, 10),
+				strconv.FormatInt(p.ConsumerOffset, 10), strconv.FormatInt(p.Lag, 10),
+			})
+		}
+	}
+	return rows
+}
+
+func totalLag(offsets []consumergroups.TopicPartitionOffsets) int64 {
+	var lag int64
+	for _, t := range offsets {
+		lag += t.TotalLag
+	}
+	return lag
+}
+
+// nextPageOffset returns the offset following the last message of the current page
+func nextPageOffset(messages []*consume.Message, pageStart int64) int64 {
+	if len(messages) == 0 {
+		return pageStart
+	}
+	return messages[len(messages)-1].Offset + 1
+}
+
+// previousPageOffset returns the start offset of the previous page. In compacted topics
+// the previous page might contain less messages.
+func previousPageOffset(pageStart int64, pageSize int, oldestOffset int64) int64 {
+	offset := pageStart - int64(pageSize)
+	if offset < oldestOffset {
+		return oldestOffset
+	}
+	return offset
+}
+
+func formatMessage(msg *consume.Message) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Partition: %d\nOffset:    %d\nTimestamp: %s\n", msg.Partition, msg.Offset, formatTimestamp(msg.Timestamp))
+
+	if len(msg.Headers) > 0 {
+		builder.WriteString("Headers:\n")
+		keys := make([]string, 0, len(msg.Headers))
+		for key := range msg.Headers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&builder, "  %s: %s\n", key, msg.Headers[key])
+		}
+	}
+
+	if msg.KeySchemaID != nil {
+		fmt.Fprintf(&builder, "Key Schema (id=%d):\n%s\n", *msg.KeySchemaID, prettyJSON(*msg.KeySchema))
+	}
+	fmt.Fprintf(&builder, "Key:\n%s\n", prettyJSON(stringOrNull(msg.Key)))
+
+	if msg.ValueSchemaID != nil {
+		fmt.Fprintf(&builder, "Value Schema (id=%d):\n%s\n", *msg.ValueSchemaID, prettyJSON(*msg.ValueSchema))
+	}
+	fmt.Fprintf(&builder, "Value:\n%s\n", prettyJSON(stringOrNull(msg.Value)))
+
+	return builder.String()
+}
+
+func prettyJSON(value string) string {
+	var buf bytes.Buffer
+	if json.Valid([]byte(value)) && json.Indent(&buf, []byte(value), "", "  ") == nil {
+		return buf.String()
+	}
+	return value
+}
+
+func formatTimestamp(timestamp *time.Time) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.Format(time.RFC3339)
+}
+
+func stringOrNull(value *string) string {
+	if value == nil {
+		return "null"
+	}
+	return *value
+}
+
+func truncate(value string, length int) string {
+	value = strings.ReplaceAll(value, "\n", " ")
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length-3]) + "..."
+}
+
+func joinInt32(values []int32) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(int(v)))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+)
+
+type fakeSource struct{}
+
+func (fakeSource) Topics() ([]topic.Topic, error) {
+	return []topic.Topic{{Name: "my-topic", Partitions: []topic.Partition{{ID: 0, NewestOffset: 2}}}}, nil
+}
+
+func (fakeSource) Topic(name string) (topic.Topic, error) {
+	return topic.Topic{Name: name, Partitions: []topic.Partition{{ID: 0, NewestOffset: 2}}}, nil
+}
+
+func (fakeSource) Messages(_ string, _ int32, offset int64, _ int) ([]*consume.Message, error) {
+	return []*consume.Message{{Offset: offset, Value: strPtr("first")}, {Offset: offset + 1, Value: strPtr("second")}}, nil
+}
+
+func (fakeSource) OffsetForTimestamp(_ string, _ int32, timestamp int64) (int64, error) {
+	if timestamp > time.Now().UnixMilli() {
+		return -1, nil
+	}
+	return 1, nil
+}
+
+func (fakeSource) ConsumerGroups() ([]string, error) {
+	return []string{"my-group"}, nil
+}
+
+func (fakeSource) ConsumerGroupOffsets(_ string) ([]consumergroups.TopicPartitionOffsets, error) {
+	return nil, nil
+}
+
+func waitFor(t *testing.T, b *browser, condition func() bool) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		result := make(chan bool, 1)
+		b.app.QueueUpdate(func() { result <- condition() })
+		if <-result {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("condition not met")
+}
+
+func TestBrowserNavigation(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("failed to init screen: %v", err)
+	}
+	screen.SetSize(120, 30)
+
+	b := newBrowser(fakeSource{}, 10)
+	b.app.SetScreen(screen)
+
+	done := make(chan error)
+	go func() { done <- b.run() }()
+
+	waitFor(t, b, func() bool { return b.topicsTable.GetCell(1, 0).Text == "my-topic" })
+
+	// drill into topic and partition
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitFor(t, b, func() bool { name, _ := b.pages.GetFrontPage(); return name == pagePartitions })
+
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitFor(t, b, func() bool { return b.messagesTable.GetCell(2, 3).Text == "second" })
+
+	// next page starts after the last message
+	screen.InjectKey(tcell.KeyRune, 'n', tcell.ModNone)
+	waitFor(t, b, func() bool { return b.pageStart == 2 })
+
+	// timestamp after the newest message
+	screen.InjectKey(tcell.KeyRune, 't', tcell.ModNone)
+	waitFor(t, b, func() bool { name, _ := b.pages.GetFrontPage(); return name == pagePrompt })
+	for _, r := range "4102444800000" {
+		screen.InjectKey(tcell.KeyRune, r, tcell.ModNone)
+	}
+	screen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitFor(t, b, func() bool { return strings.Contains(b.status.GetText(true), "no messages after") })
+
+	// consumer groups
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	waitFor(t, b, func() bool { name, _ := b.pages.GetFrontPage(); return name == pagePartitions })
+	screen.InjectKey(tcell.KeyRune, 'g', tcell.ModNone)
+	waitFor(t, b, func() bool { return b.groupsTable.GetCell(1, 0).Text == "my-group" })
+
+	screen.InjectKey(tcell.KeyRune, 'q', tcell.ModNone)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ui did not stop")
+	}
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+)
+
+func strPtr(value string) *string {
+	return &value
+}
+
+func TestNextPageOffset(t *testing.T) {
+	messages := []*consume.Message{{Offset: 10}, {Offset: 12}, {Offset: 15}}
+
+	if offset := nextPageOffset(messages, 10); offset != 16 {
+		t.Errorf("expected 16, got %d", offset)
+	}
+	if offset := nextPageOffset(nil, 10); offset != 10 {
+		t.Errorf("expected 10, got %d", offset)
+	}
+}
+
+func TestPreviousPageOffset(t *testing.T) {
+	if offset := previousPageOffset(100, 20, 0); offset != 80 {
+		t.Errorf("expected 80, got %d", offset)
+	}
+	if offset := previousPageOffset(15, 20, 5); offset != 5 {
+		t.Errorf("expected 5, got %d", offset)
+	}
+}
+
+func TestTopicsRows(t *testing.T) {
+	topics := []topic.Topic{{
+		Name:              "my-topic",
+		ReplicationFactor: 3,
+		Partitions:        []topic.Partition{{ID: 0, OldestOffset: 5, NewestOffset: 10}, {ID: 1, OldestOffset: 0, NewestOffset: 7}},
+	}}
+
+	rows := topicsRows(topics)
+
+	expected := "my-topic|2|3|12"
+	if actual := strings.Join(rows[1], "|"); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestGroupOffsetsRowsAndTotalLag(t *testing.T) {
+	offsets := []consumergroups.TopicPartitionOffsets{{
+		Name:       "my-topic",
+		TotalLag:   7,
+		Partitions: []consumergroups.PartitionOffset{{Partition: 0, NewestOffset: 10, OldestOffset: 0, ConsumerOffset: 3, Lag: 7}},
+	}}
+
+	rows := groupOffsetsRows(offsets)
+
+	expected := "my-topic|0|10|0|3|7"
+	if actual := strings.Join(rows[1], "|"); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+	if lag := totalLag(offsets); lag != 7 {
+		t.Errorf("expected 7, got %d", lag)
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	schemaID := 4
+
+	msg := &consume.Message{
+		Partition:     1,
+		Offset:        42,
+		Timestamp:     &timestamp,
+		Headers:       map[string]string{"b": "2", "a": "1"},
+		Key:           strPtr("my-key"),
+		Value:         strPtr(`{"name":"Alice"}`),
+		ValueSchema:   strPtr(`{"type":"record"}`),
+		ValueSchemaID: &schemaID,
+	}
+
+	formatted := formatMessage(msg)
+
+	for _, expected := range []string{
+		"Offset:    42",
+		"Timestamp: 2024-01-02T03:04:05Z",
+		"  a: 1\n  b: 2",
+		"Value Schema (id=4):",
+		"Value:\n{\n  \"name\": \"Alice\"\n}",
+	} {
+		if !strings.Contains(formatted, expected) {
+			t.Errorf("expected formatted message to contain %q:\n%s", expected, formatted)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if value := truncate("short", 10); value != "short" {
+		t.Errorf("expected short, got %s", value)
+	}
+	if value := truncate("a long\nvalue", 8); value != "a lon..." {
+		t.Errorf("expected 'a lon...', got %s", value)
+	}
+}
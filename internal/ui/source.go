@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/consume"
+	"github.com/deviceinsight/kafkactl/v5/internal/consumergroups"
+	"github.com/deviceinsight/kafkactl/v5/internal/topic"
+)
+
+// dataSource provides the data shown in the ui
+type dataSource interface {
+	Topics() ([]topic.Topic, error)
+	Topic(name string) (topic.Topic, error)
+	Messages(topic string, partition int32, offset int64, count int) ([]*consume.Message, error)
+	// OffsetForTimestamp returns -1 if there is no message at or after the timestamp
+	OffsetForTimestamp(topic string, partition int32, timestamp int64) (int64, error)
+	ConsumerGroups() ([]string, error)
+	ConsumerGroupOffsets(group string) ([]consumergroups.TopicPartitionOffsets, error)
+}
+
+// messageFlags defines the message details that are shown in the ui
+var messageFlags = consume.Flags{
+	PrintKeys:       true,
+	PrintHeaders:    true,
+	PrintTimestamps: true,
+	PrintSchema:     true,
+	PrintPartitions: true,
+}
+
+type kafkaSource struct {
+	clientContext internal.ClientContext
+	client        sarama.Client
+	admin         sarama.ClusterAdmin
+
+	mutex         sync.Mutex
+	deserializers map[string]consume.MessageDeserializerChain
+}
+
+func newKafkaSource(clientContext internal.ClientContext) (*kafkaSource, error) {
+
+	client, err := internal.CreateClient(&clientContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create client")
+	}
+
+	admin, err := internal.CreateClusterAdmin(&clientContext)
+	if err != nil {
+		_ = client.Close()
+		return nil, errors.Wrap(err, "failed to create cluster admin")
+	}
+
+	return &kafkaSource{
+		clientContext: clientContext,
+		client:        client,
+		admin:         admin,
+		deserializers: make(map[string]consume.MessageDeserializerChain),
+	}, nil
+}
+
+func (source *kafkaSource) Topics() ([]topic.Topic, error) {
+
+	if err := source.client.RefreshMetadata(); err != nil {
+		return nil, errors.Wrap(err, "failed to refresh metadata")
+	}
+
+	topics, err := source.client.Topics()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read topics")
+	}
+
+	return topic.ReadTopics(&source.client, &source.admin, topics), nil
+}
+
+func (source *kafkaSource) Topic(name string) (topic.Topic, error) {
+
+	topics := topic.ReadTopics(&source.client, &source.admin, []string{name})
+	if len(topics) == 0 || topics[0].Name == "" {
+		return topic.Topic{}, errors.Errorf("failed to read topic: %s", name)
+	}
+	return topics[0], nil
+}
+
+func (source *kafkaSource) Messages(topicName string, partition int32, offset int64, count int) ([]*consume.Message, error) {
+
+	deserializers, err := source.deserializerChain(topicName)
+	if err != nil {
+		return nil, err
+	}
+
+	return consume.ReadMessages(source.client, topicName, partition, offset, count, deserializers, messageFlags)
+}
+
+func (source *kafkaSource) deserializerChain(topicName string) (consume.MessageDeserializerChain, error) {
+	source.mutex.Lock()
+	defer source.mutex.Unlock()
+
+	if deserializers, ok := source.deserializers[topicName]; ok {
+		return deserializers, nil
+	}
+
+	deserializers, err := consume.CreateMessageDeserializerChain(topicName, source.clientContext, messageFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	source.deserializers[topicName] = deserializers
+	return deserializers, nil
+}
+
+func (source *kafkaSource) OffsetForTimestamp(topicName string, partition int32, timestamp int64) (int64, error) {
+	offset, err := source.client.GetOffset(topicName, partition, timestamp)
+	if err != nil {
+		return -1, errors.Wrapf(err, "failed to get offset for timestamp %d", timestamp)
+	}
+	return offset, nil
+}
+
+func (source *kafkaSource) ConsumerGroups() ([]string, error) {
+	return consumergroups.ListConsumerGroupNames(source.admin)
+}
+
+func (source *kafkaSource) ConsumerGroupOffsets(group string) ([]consumergroups.TopicPartitionOffsets, error) {
+	return consumergroups.ReadConsumerGroupOffsets(source.client, source.admin, group)
+}
+
+func (source *kafkaSource) Close() {
+	_ = source.admin.Close()
+	_ = source.client.Close()
+}
@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/deviceinsight/kafkactl/v5/internal"
+)
+
+type Flags struct {
+	PageSize int
+}
+
+type Operation struct{}
+
+func (operation *Operation) Run(flags Flags) error {
+
+	if flags.PageSize <= 0 {
+		return errors.Errorf("page size must be positive: %d", flags.PageSize)
+	}
+
+	clientContext, err := internal.CreateClientContext()
+	if err != nil {
+		return err
+	}
+
+	source, err := newKafkaSource(clientContext)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	return newBrowser(source, flags.PageSize).run()
+}
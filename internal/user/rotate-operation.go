@@ -0,0 +1,157 @@
+package user
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/deviceinsight/kafkactl/v5/internal"
+	"github.com/deviceinsight/kafkactl/v5/internal/credential"
+	"github.com/deviceinsight/kafkactl/v5/internal/output"
+	"github.com/pkg/errors"
+)
+
+const (
+	verifyLoginAttempts = 5
+	verifyLoginBackoff  = 2 * time.Second
+)
+
+// RotateUser sets a new password for both SCRAM mechanisms and verifies that the user is able to login with it.
+// If the user is the identity of the current context, the stored password of the context is updated.
+func (operation *Operation) RotateUser(username string, flags AlterUserFlags) error {
+
+	var (
+		err     error
+		context internal.ClientContext
+		admin   sarama.ClusterAdmin
+	)
+
+	if flags.Salt != "" {
+		return errors.New("--salt cannot be used together with --rotate")
+	}
+
+	if flags.Generate && flags.Password != "" {
+		return errors.New("--password and --generate cannot be used together")
+	}
+
+	if context, err = internal.CreateClientContext(); err != nil {
+		return err
+	}
+
+	password := flags.Password
+	if flags.Generate {
+		if password, err = generatePassword(); err != nil {
+			return errors.Wrap(err, "failed to generate password")
+		}
+	} else if password == "" {
+		if password, err = credential.NewPromptCredentialResolver().ResolvePassword("", "New Password"); err != nil {
+			return err
+		}
+	}
+
+	if password == "" {
+		return errors.New("password must not be empty")
+	}
+
+	if admin, err = internal.CreateClusterAdmin(&context); err != nil {
+		return errors.Wrap(err, "failed to create cluster admin")
+	}
+	defer admin.Close()
+
+	iterations := flags.Iterations
+	if iterations <= 0 {
+		iterations = 4096
+	}
+
+	upserts := make([]sarama.AlterUserScramCredentialsUpsert, 0, 2)
+	for _, mechanism := range []sarama.ScramMechanismType{sarama.SCRAM_MECHANISM_SHA_256, sarama.SCRAM_MECHANISM_SHA_512} {
+		salt, err := generateRandomSalt()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate salt")
+		}
+		upserts = append(upserts, sarama.AlterUserScramCredentialsUpsert{
+			Name:       username,
+			Mechanism:  mechanism,
+			Iterations: iterations,
+			Salt:       salt,
+			Password:   []byte(password),
+		})
+	}
+
+	response, err := admin.UpsertUserScramCredentials(upserts)
+	if err != nil {
+		return errors.Wrap(err, "failed to rotate user credentials")
+	}
+
+	for _, result := range response {
+		if result.User == username && result.ErrorCode != sarama.ErrNoError {
+			errorMsg := ""
+			if result.ErrorMessage != nil {
+				errorMsg = *result.ErrorMessage
+			}
+			return errors.Errorf("failed to rotate credentials of user '%s': %s", username, errorMsg)
+		}
+	}
+
+	output.Infof("user '%s' credentials have been rotated for SCRAM-SHA-256 and SCRAM-SHA-512", username)
+
+	// the credentials have been changed already, so the password is stored even if the verification fails
+	var verifyErr error
+	if context.Sasl.Enabled && strings.HasPrefix(strings.ToLower(context.Sasl.Mechanism), "scram") && !context.Sasl.TokenAuth {
+		if verifyErr = verifyLogin(context, username, password); verifyErr == nil {
+			output.Infof("login of user '%s' with the new password has been verified", username)
+		}
+	} else {
+		output.Warnf("login of user '%s' is not verified, because the context does not use a SCRAM mechanism", username)
+	}
+
+	stored := ""
+	if context.Sasl.Enabled && context.Sasl.Username == username && !context.Sasl.TokenAuth {
+		stored, err = internal.UpdateSaslPassword(&context, password)
+		if err != nil {
+			err = errors.Wrapf(err, "password of context '%s' has not been updated", context.Name)
+		} else if stored != "" {
+			output.Infof("password of context '%s' has been updated in %s", context.Name, stored)
+		}
+	}
+
+	if flags.Generate && stored == "" {
+		output.Infof("generated password: %s", password)
+	}
+
+	if verifyErr != nil {
+		return verifyErr
+	}
+	return err
+}
+
+// verifyLogin creates a new client with the credentials of the user. It is retried, because the
+// new credentials might not have been propagated to all brokers yet.
+func verifyLogin(context internal.ClientContext, username, password string) error {
+
+	context.Sasl.Username = username
+	context.Sasl.Password = password
+
+	var err error
+	for attempt := 1; attempt <= verifyLoginAttempts; attempt++ {
+		var client sarama.Client
+		if client, err = internal.CreateClient(&context); err == nil {
+			return client.Close()
+		}
+		output.Debugf("login of user '%s' failed (attempt %d/%d): %v", username, attempt, verifyLoginAttempts, err)
+		if attempt < verifyLoginAttempts {
+			time.Sleep(verifyLoginBackoff)
+		}
+	}
+	return errors.Wrapf(err, "login of user '%s' with the new password failed", username)
+}
+
+func generatePassword() (string, error) {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(password), nil
+}
@@ -0,0 +1,22 @@
+package user
+
+import "testing"
+
+func TestGeneratePassword(t *testing.T) {
+
+	first, err := generatePassword()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := generatePassword()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(first) != 43 {
+		t.Fatalf("expected password with 43 characters, got %d", len(first))
+	}
+	if first == second {
+		t.Fatalf("expected different passwords")
+	}
+}
@@ -35,6 +35,8 @@ type AlterUserFlags struct {
 	Password   string
 	Salt       string
 	Iterations int32
+	Rotate     bool
+	Generate   bool
 }
 
 type DeleteUserFlags struct {
@@ -125,8 +127,22 @@ func (operation *Operation) CreateUser(username string, flags CreateUserFlags) e
 }
 
 func (operation *Operation) AlterUser(username string, flags AlterUserFlags) error {
+
+	if flags.Rotate {
+		return operation.RotateUser(username, flags)
+	}
+
+	if flags.Generate {
+		return errors.New("--generate can only be used together with --rotate")
+	}
+
 	// Same logic as CreateUser - SCRAM credentials are upserted
-	createFlags := CreateUserFlags(flags)
+	createFlags := CreateUserFlags{
+		Mechanism:  flags.Mechanism,
+		Password:   flags.Password,
+		Salt:       flags.Salt,
+		Iterations: flags.Iterations,
+	}
 
 	err := operation.CreateUser(username, createFlags)
 	if err != nil {
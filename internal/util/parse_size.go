@@ -0,0 +1,69 @@
+package util
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var sizeUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"KIB", 1 << 10},
+	{"MIB", 1 << 20},
+	{"GIB", 1 << 30},
+	{"KB", 1000},
+	{"MB", 1000 * 1000},
+	{"GB", 1000 * 1000 * 1000},
+	{"K", 1000},
+	{"M", 1000 * 1000},
+	{"G", 1000 * 1000 * 1000},
+	{"B", 1},
+}
+
+// ParseSize parses a human-readable byte size like 100MB, 512KiB or 1024 into the number of bytes.
+func ParseSize(rawSize string) (int64, error) {
+
+	size := strings.ToUpper(strings.TrimSpace(rawSize))
+	multiplier := int64(1)
+
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(size, unit.suffix) {
+			size = strings.TrimSpace(strings.TrimSuffix(size, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	value, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || value < 0 {
+		return 0, errors.Errorf("unable to parse size: %s", rawSize)
+	}
+
+	return value * multiplier, nil
+}
+
+// FormatSize formats a number of bytes as human-readable size like 1.5GB.
+func FormatSize(bytes int64) string {
+
+	units := []string{"KB", "MB", "GB", "TB", "PB"}
+
+	if bytes < 1000 {
+		return strconv.FormatInt(bytes, 10) + "B"
+	}
+
+	size := float64(bytes)
+	unit := ""
+	for _, unit = range units {
+		size /= 1000
+		// move to the next unit if the size would be rounded to 1000.0
+		if math.Round(size*10) < 10000 {
+			break
+		}
+	}
+
+	return strconv.FormatFloat(size, 'f', 1, 64) + unit
+}
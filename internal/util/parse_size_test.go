@@ -0,0 +1,68 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/deviceinsight/kafkactl/v5/internal/util"
+)
+
+func TestParseSize(t *testing.T) {
+
+	type testCases struct {
+		input    string
+		wantSize int64
+		wantErr  bool
+	}
+
+	for _, test := range []testCases{
+		{input: "1024", wantSize: 1024},
+		{input: "100B", wantSize: 100},
+		{input: "100MB", wantSize: 100 * 1000 * 1000},
+		{input: "100mb", wantSize: 100 * 1000 * 1000},
+		{input: "2 GB", wantSize: 2 * 1000 * 1000 * 1000},
+		{input: "512KiB", wantSize: 512 * 1024},
+		{input: "1MiB", wantSize: 1024 * 1024},
+		{input: "1M", wantSize: 1000 * 1000},
+		{input: "abc", wantErr: true},
+		{input: "-1MB", wantErr: true},
+		{input: "", wantErr: true},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			size, err := util.ParseSize(test.input)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("expected error for input %q", test.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if size != test.wantSize {
+				t.Errorf("expected %d, got %d", test.wantSize, size)
+			}
+		})
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+
+	for input, want := range map[int64]string{
+		0:                      "0B",
+		999:                    "999B",
+		1000:                   "1.0KB",
+		1500000:                "1.5MB",
+		2 * 1000 * 1000 * 1000: "2.0GB",
+		1049:                   "1.0KB",
+		999949:                 "999.9KB",
+		999950:                 "1.0MB",
+		999999:                 "1.0MB",
+		999949999:              "999.9MB",
+		999999999:              "1.0GB",
+		999999999999:           "1.0TB",
+	} {
+		if size := util.FormatSize(input); size != want {
+			t.Errorf("expected %s for %d, got %s", want, input, size)
+		}
+	}
+}